@@ -0,0 +1,10 @@
+action "aiven_kafka_connector_restart" "example" {
+  config {
+    project        = "my-project"
+    service_name   = "my-kafka"
+    connector_name = "my-connector"
+    task_id        = 0
+  }
+}
+
+# Run with: terraform apply -invoke=action.aiven_kafka_connector_restart.example
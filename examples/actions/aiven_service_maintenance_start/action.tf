@@ -0,0 +1,8 @@
+action "aiven_service_maintenance_start" "example" {
+  config {
+    project      = "my-project"
+    service_name = "my-pg"
+  }
+}
+
+# Run with: terraform apply -invoke=action.aiven_service_maintenance_start.example
@@ -0,0 +1,9 @@
+action "aiven_service_power" "off" {
+  config {
+    project      = "my-project"
+    service_name = "my-pg"
+    powered      = false
+  }
+}
+
+# Run with: terraform apply -invoke=action.aiven_service_power.off
@@ -0,0 +1,9 @@
+action "aiven_service_user_password_reset" "example" {
+  config {
+    project      = "my-project"
+    service_name = "my-pg"
+    username     = "avnadmin"
+  }
+}
+
+# Run with: terraform apply -invoke=action.aiven_service_user_password_reset.example
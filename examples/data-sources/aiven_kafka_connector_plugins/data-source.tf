@@ -0,0 +1,11 @@
+data "aiven_kafka_connector_plugins" "example_plugins" {
+  project      = data.aiven_project.example_project.project
+  service_name = aiven_kafka.example_kafka.service_name
+}
+
+output "opensearch_sink_config" {
+  value = [
+    for p in data.aiven_kafka_connector_plugins.example_plugins.plugins : p.config
+    if p.class == "io.aiven.kafka.connect.opensearch.OpensearchSinkConnector"
+  ]
+}
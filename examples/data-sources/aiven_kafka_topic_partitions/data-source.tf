@@ -0,0 +1,9 @@
+data "aiven_kafka_topic_partitions" "example_partitions" {
+  project      = data.aiven_project.example_project.project
+  service_name = aiven_kafka.example_kafka.service_name
+  topic_name   = aiven_kafka_topic.example_topic.topic_name
+}
+
+output "under_replicated_partitions" {
+  value = data.aiven_kafka_topic_partitions.example_partitions.under_replicated_partitions
+}
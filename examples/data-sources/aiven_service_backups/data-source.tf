@@ -0,0 +1,17 @@
+data "aiven_service_backups" "example" {
+  project      = "my-project"
+  service_name = "my-pg"
+  from_time    = "2026-01-02T15:04:05Z"
+  most_recent  = 1
+  to_time      = "2026-01-02T15:04:05Z"
+
+  /* COMPUTED FIELDS
+  backups {
+    backup_name      = "foo"
+    backup_time      = "foo"
+    base_backup      = true
+    data_size        = 42
+    storage_location = "foo"
+  }
+  */
+}
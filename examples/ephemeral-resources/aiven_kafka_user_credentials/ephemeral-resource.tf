@@ -0,0 +1,12 @@
+ephemeral "aiven_kafka_user_credentials" "example" {
+  project      = "my-project"
+  service_name = "my-kafka"
+  username     = "testuser"
+
+  /* COMPUTED FIELDS
+  access_cert = "foo"
+  access_key  = "foo"
+  password    = "password123"
+  type        = "foo"
+  */
+}
@@ -0,0 +1,10 @@
+ephemeral "aiven_opensearch_user_credentials" "example" {
+  project      = "my-project"
+  service_name = "my-opensearch"
+  username     = "testuser"
+
+  /* COMPUTED FIELDS
+  password = "password123"
+  type     = "foo"
+  */
+}
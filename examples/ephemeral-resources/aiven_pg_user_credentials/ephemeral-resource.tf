@@ -0,0 +1,13 @@
+ephemeral "aiven_pg_user_credentials" "example" {
+  project      = "my-project"
+  service_name = "my-pg"
+  username     = "testuser"
+
+  /* COMPUTED FIELDS
+  access_cert          = "foo"
+  access_key           = "foo"
+  password             = "password123"
+  pg_allow_replication = true
+  type                 = "foo"
+  */
+}
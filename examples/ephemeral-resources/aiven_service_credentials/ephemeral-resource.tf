@@ -0,0 +1,4 @@
+ephemeral "aiven_service_credentials" "example" {
+  project      = "my-project"
+  service_name = "my-pg"
+}
@@ -0,0 +1,3 @@
+output "pg_user_id" {
+  value = provider::aiven::build_id("my-project", "my-pg", "my-user")
+}
@@ -0,0 +1,11 @@
+locals {
+  pg_uri = provider::aiven::parse_service_uri(aiven_pg.example.service_uri)
+}
+
+output "pg_host" {
+  value = local.pg_uri.host
+}
+
+output "pg_sslmode" {
+  value = local.pg_uri.query["sslmode"]
+}
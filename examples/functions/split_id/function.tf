@@ -0,0 +1,6 @@
+locals {
+  # The optional size fails the plan if the ID has a different number of parts
+  pg_user_parts = provider::aiven::split_id(aiven_pg_user.example.id, 3)
+  project       = local.pg_user_parts[0]
+  service_name  = local.pg_user_parts[1]
+}
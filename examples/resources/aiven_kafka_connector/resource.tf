@@ -10,6 +10,10 @@ resource "aiven_kafka_connector" "kafka-os-connector" {
     "type.name"           = "os-connector"
     "connection.url"      = aiven_opensearch.example_os.service_uri
     "connection.username" = aiven_opensearch.example_os.service_username
+  }
+
+  # Hidden in the plan output
+  sensitive_config = {
     "connection.password" = aiven_opensearch.example_os.service_password
   }
 }
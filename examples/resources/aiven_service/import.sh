@@ -0,0 +1 @@
+terraform import aiven_service.example_service PROJECT/SERVICE_NAME
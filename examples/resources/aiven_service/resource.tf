@@ -0,0 +1,21 @@
+resource "aiven_service" "example_service" {
+  project      = data.aiven_project.example_project.project
+  service_type = "pg"
+  plan         = "startup-4"
+  cloud_name   = "google-europe-west1"
+  service_name = "example-pg-service"
+
+  user_config = jsonencode({
+    pg_version = "16"
+    pg = {
+      max_connections = 100
+    }
+  })
+}
+
+# To manage an existing aiven_pg resource with aiven_service, replace it with
+# the aiven_service resource and add the moved block:
+# moved {
+#   from = aiven_pg.example_pg
+#   to   = aiven_service.example_service
+# }
@@ -0,0 +1 @@
+terraform import aiven_service_maintenance.example PROJECT/SERVICE_NAME
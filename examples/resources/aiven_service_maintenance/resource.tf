@@ -0,0 +1,20 @@
+# Apply the pending updates to the development service first, then to the production service
+resource "aiven_service_maintenance" "dev" {
+  project      = data.aiven_project.example_project.project
+  service_name = aiven_pg.dev.service_name
+
+  triggers = {
+    rollout = "2026-11"
+  }
+}
+
+resource "aiven_service_maintenance" "prod" {
+  project      = data.aiven_project.example_project.project
+  service_name = aiven_pg.prod.service_name
+
+  triggers = {
+    rollout = "2026-11"
+  }
+
+  depends_on = [aiven_service_maintenance.dev]
+}
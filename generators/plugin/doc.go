@@ -13,10 +13,11 @@ import (
 )
 
 const (
-	docsResourcesDir   = "docs-plugin/resources"
-	docsDataSourcesDir = "docs-plugin/data-sources"
-	templatesDir       = "templates"
-	providerDocName    = "terraform-provider-aiven"
+	docsResourcesDir          = "docs-plugin/resources"
+	docsDataSourcesDir        = "docs-plugin/data-sources"
+	docsEphemeralResourcesDir = "docs-plugin/ephemeral-resources"
+	templatesDir              = "templates"
+	providerDocName           = "terraform-provider-aiven"
 )
 
 type docGroup struct {
@@ -39,29 +40,31 @@ type docNestedType struct {
 }
 
 func docEntityLabel(entity entityType) string {
-	if entity.isResource() {
+	switch entity {
+	case resourceType:
 		return "Resource"
+	case ephemeralType:
+		return "Ephemeral Resource"
 	}
 	return "Data Source"
 }
 
-func docFileName(def *Definition) string {
-	return strings.TrimPrefix(def.typeName, typeNamePrefix) + ".md"
+func docFileName(def *Definition, entity entityType) string {
+	return strings.TrimPrefix(def.EntityTypeName(entity), typeNamePrefix) + ".md"
 }
 
 func docOutputDir(entity entityType) string {
-	if entity.isResource() {
+	switch entity {
+	case resourceType:
 		return docsResourcesDir
+	case ephemeralType:
+		return docsEphemeralResourcesDir
 	}
 	return docsDataSourcesDir
 }
 
 func hasDocTemplateOverride(def *Definition, entity entityType) bool {
-	entityDir := "resources"
-	if entity.IsDataSource() {
-		entityDir = "data-sources"
-	}
-	path := filepath.Join(templatesDir, entityDir, docFileName(def)+".tmpl")
+	path := filepath.Join(templatesDir, entity.Kebab()+"s", docFileName(def, entity)+".tmpl")
 	_, err := os.Stat(path)
 	return err == nil
 }
@@ -90,7 +93,7 @@ func genDoc(def *Definition, entity entityType, renderRoot *Item, resDat *Schema
 	docWriteFrontMatter(&b, def, entity, description)
 
 	b.WriteString("# ")
-	b.WriteString(def.typeName)
+	b.WriteString(def.EntityTypeName(entity))
 	b.WriteString(" (")
 	b.WriteString(docEntityLabel(entity))
 	b.WriteString(")\n\n")
@@ -146,7 +149,7 @@ func docWriteFrontMatter(b *strings.Builder, def *Definition, entity entityType,
 
 	b.WriteString("---\n")
 	b.WriteString("page_title: \"")
-	b.WriteString(def.typeName)
+	b.WriteString(def.EntityTypeName(entity))
 	b.WriteString(" ")
 	b.WriteString(docEntityLabel(entity))
 	b.WriteString(" - ")
@@ -163,8 +166,7 @@ func docWriteFrontMatter(b *strings.Builder, def *Definition, entity entityType,
 }
 
 func docExample(def *Definition, entity entityType, renderRoot *Item, resDat *SchemaMeta) (string, bool, error) {
-	entityString := strings.ReplaceAll(entity.String(), "data", "data-")
-	examplePath := filepath.Join(examplesDir, entityString+"s", def.typeName, entityString+".tf")
+	examplePath := filepath.Join(examplesDir, entity.Kebab()+"s", def.EntityTypeName(entity), entity.Kebab()+".tf")
 	content, err := os.ReadFile(examplePath)
 	if err != nil && !os.IsNotExist(err) {
 		return "", false, fmt.Errorf("read example %s: %w", examplePath, err)
@@ -473,9 +475,13 @@ func docWriteTimeoutsSection(b *strings.Builder, def *Definition, entity entityT
 			},
 		)
 	} else {
+		name := "read"
+		if entity.IsEphemeral() {
+			name = "open"
+		}
 		attrs = []timeoutAttr{
 			{
-				name:        "read",
+				name:        name,
 				description: "A string that can be [parsed as a duration](https://pkg.go.dev/time#ParseDuration) consisting of numbers and unit suffixes, such as \"30s\" or \"2h45m\". Valid time units are \"s\" (seconds), \"m\" (minutes), \"h\" (hours).",
 			},
 		}
@@ -16,12 +16,15 @@ import (
 // exampleRoot generates example usage for the TF resource or data source.
 func exampleRoot(def *Definition, entity entityType, item *Item) ([]byte, error) {
 	t := "data"
-	if entity.isResource() {
+	switch entity {
+	case resourceType:
 		t = "resource"
+	case ephemeralType:
+		t = "ephemeral"
 	}
 
 	f := hclwrite.NewEmptyFile()
-	rootBody := f.Body().AppendNewBlock(t, []string{def.typeName, "example"}).Body()
+	rootBody := f.Body().AppendNewBlock(t, []string{def.EntityTypeName(entity), "example"}).Body()
 	err := exampleObjectItem(def, entity, item, rootBody, false)
 	if err != nil {
 		return nil, err
@@ -129,7 +132,7 @@ func exampleObjectItem(def *Definition, entity entityType, item *Item, body *hcl
 		// Doesn't add title if it's the first item, except for lookup.
 		// Doesn't add title if the block is in a computed block.
 		var comment string
-		hasLookup := !entity.isResource() && def.DatasourceLookupOp() != nil
+		hasLookup := entity.IsDataSource() && def.DatasourceLookupOp() != nil
 		if !inComputedBlock && (i > 0 || hasLookup) {
 			if !seenOptional && v.IsOptional(def, entity) {
 				seenOptional = true
@@ -462,7 +465,7 @@ func lookupExampleHint(def *Definition, root *Item) string {
 // no uncommented primary.
 func datasourceAlternativeAttrs(def *Definition, entity entityType, item *Item) (map[string]bool, error) {
 	out := make(map[string]bool)
-	if entity.isResource() || !item.IsRoot() {
+	if !entity.IsDataSource() || !item.IsRoot() {
 		return out, nil
 	}
 	live, err := liveExactlyOneOf(def, item)
@@ -11,10 +11,11 @@ type entityType string
 const (
 	resourceType   entityType = "resource"
 	datasourceType entityType = "datasource"
+	ephemeralType  entityType = "ephemeral"
 )
 
 func listEntityTypes() []entityType {
-	return []entityType{resourceType, datasourceType}
+	return []entityType{resourceType, datasourceType, ephemeralType}
 }
 
 func (e entityType) isResource() bool {
@@ -25,19 +26,39 @@ func (e entityType) IsDataSource() bool {
 	return e == datasourceType
 }
 
+// IsEphemeral ephemeral resources are read-only, like datasources, but never persisted in the state.
+func (e entityType) IsEphemeral() bool {
+	return e == ephemeralType
+}
+
 func (e entityType) String() string {
 	return string(e)
 }
 
 func (e entityType) Title() string {
-	if e == resourceType {
+	switch e {
+	case resourceType:
 		return "Resource"
+	case ephemeralType:
+		return "EphemeralResource"
 	}
 
 	// In camelcase
 	return "DataSource"
 }
 
+// Kebab returns the name used in the docs and examples directories:
+// "resource", "data-source", "ephemeral-resource".
+func (e entityType) Kebab() string {
+	switch e {
+	case datasourceType:
+		return "data-source"
+	case ephemeralType:
+		return "ephemeral-resource"
+	}
+	return string(e)
+}
+
 func (e entityType) Import(importString entityImportType) string {
 	return fmt.Sprintf(string(importString), e)
 }
@@ -65,6 +86,7 @@ const (
 	avnGenPackage           = "github.com/aiven/go-client-codegen"
 	errMsgPackage           = "github.com/aiven/terraform-provider-aiven/internal/plugin/errmsg"
 	datasourcePkg           = "github.com/hashicorp/terraform-plugin-framework/datasource"
+	listPackage             = "github.com/hashicorp/terraform-plugin-framework/list"
 )
 
 func getUntypedImports() []string {
@@ -154,12 +154,18 @@ type SchemaMeta struct {
 	DisableExample      bool              `yaml:"disableExample,omitempty"`
 	ValidateConfig      bool              `yaml:"validateConfig,omitempty"`
 	ModifyPlan          bool              `yaml:"modifyPlan,omitempty"`
+	List                bool              `yaml:"list,omitempty"`
 
 	// SchemaOverride is a datasource-only schema overlay merged on top of the
 	// base Definition.Schema when generating the datasource. Resource
 	// generation ignores this field. Only meaningful on Definition.Datasource.
 	SchemaOverride map[string]*Item `yaml:"schemaOverride,omitempty"`
 
+	// TypeName overrides the Terraform type name of the entity.
+	// Only meaningful on Definition.Ephemeral, because ephemeral resources expose
+	// credentials rather than the object itself, e.g. "aiven_pg_user_credentials".
+	TypeName string `yaml:"typeName,omitempty"`
+
 	// ExactlyOneOf declares the datasource's top-level "exactly one of"
 	// discriminator group. Drives the example LOOKUP hint and the
 	// `datasourcevalidator.ExactlyOneOf` block in datasourceConfigValidators.
@@ -177,6 +183,7 @@ type Definition struct {
 	Rename              map[string]string `yaml:"rename,omitempty"`
 	Resource            *SchemaMeta       `yaml:"resource,omitempty"`
 	Datasource          *SchemaMeta       `yaml:"datasource,omitempty"`
+	Ephemeral           *SchemaMeta       `yaml:"ephemeral,omitempty"`
 	IDAttributeComposed []string          `yaml:"idAttributeComposed,omitempty"`
 	LegacyTimeouts      bool              `yaml:"legacyTimeouts,omitempty"`
 	Operations          Operations        `yaml:"operations"`
@@ -187,6 +194,15 @@ type Definition struct {
 	FlattenModifier     bool              `yaml:"flattenModifier,omitempty"`
 }
 
+// EntityTypeName returns the Terraform type name for the given entity.
+// Ephemeral resources might override it, see SchemaMeta.TypeName.
+func (d *Definition) EntityTypeName(entity entityType) string {
+	if entity.IsEphemeral() && d.Ephemeral != nil && d.Ephemeral.TypeName != "" {
+		return d.Ephemeral.TypeName
+	}
+	return d.typeName
+}
+
 // IsRemoved reports whether the given JSON path is excluded by the `remove` list.
 // Entries are glob patterns matched against the slash-separated JSON path:
 // `*` and `?` stay within a single segment, `**` spans segments, and a pattern
@@ -466,7 +482,7 @@ func (item *Item) UsesStateForUnknown() bool {
 func (item *Item) PropertiesByEntity(entity entityType) map[string]*Item {
 	props := maps.Clone(item.Properties)
 	for k, v := range item.Properties {
-		if entity.IsDataSource() && v.WriteOnly {
+		if !entity.isResource() && v.WriteOnly {
 			delete(props, k)
 			for _, a := range v.AlsoRequires {
 				delete(props, a)
@@ -15,6 +15,8 @@ import (
 	"github.com/samber/lo"
 	"golang.org/x/tools/imports"
 	"gopkg.in/yaml.v3"
+
+	"github.com/aiven/terraform-provider-aiven/internal/plugin/sensitive"
 )
 
 func init() {
@@ -29,17 +31,6 @@ const (
 	importFileName   = "import.sh" // Terraform import command for the documentation page
 )
 
-// sensitiveFields is a list of field names that should be marked as sensitive by default
-// even if not marked in the definitions list.
-var sensitiveFields = []string{
-	"access_cert",
-	"access_key",
-	"private_key",
-	"password",
-	"password_wo",
-	"service_uri",
-}
-
 func main() {
 	log.Logger = log.Output(zerolog.ConsoleWriter{Out: os.Stderr, TimeFormat: ""})
 
@@ -82,6 +73,8 @@ func exec() error {
 		provider.ImportName(entity.Import(entityPackage), string(entity))
 		genEntityProvider(provider, entity, definitions)
 	}
+	provider.ImportName(listPackage, "list")
+	genListProvider(provider, definitions)
 
 	providerPath := genFilePath(providerFilePath, providerFileName)
 	err = saveGoFile(provider, providerPath)
@@ -96,6 +89,9 @@ const (
 	// typeName resource and datasource name, e.g. typeName = "aiven_foo_bar"
 	typeName = "typeName"
 
+	// ephemeralTypeName ephemeral resource name, e.g. ephemeralTypeName = "aiven_foo_bar_credentials"
+	ephemeralTypeName = "ephemeralTypeName"
+
 	// typeNamePrefix is a prefix for the resource and datasource names: aiven_pg, aiven_kafka, etc.
 	typeNamePrefix = "aiven_"
 
@@ -107,14 +103,15 @@ const (
 
 // genDefinition generates zz_datasource.go, zz_resource.go, and zz_converters.go files
 func genDefinition(doc *OpenAPIDoc, def *Definition) error {
-	if def.Resource == nil && def.Datasource == nil {
-		return fmt.Errorf("resource %q has no resource or datasource defined", def.fileName)
+	if def.Resource == nil && def.Datasource == nil && def.Ephemeral == nil {
+		return fmt.Errorf("resource %q has no resource, datasource or ephemeral defined", def.fileName)
 	}
 
-	// Stores datasource and resource functions in separate files because they have different imports
+	// Stores datasource, resource and ephemeral functions in separate files because they have different imports
 	files := map[entityType]*SchemaMeta{
 		resourceType:   def.Resource,
 		datasourceType: def.Datasource,
+		ephemeralType:  def.Ephemeral,
 	}
 
 	// Some operations must be done once: when either resource or datasource is generated
@@ -170,6 +167,9 @@ func genDefinition(doc *OpenAPIDoc, def *Definition) error {
 
 			// Renders the resourceName constant
 			codes = append(codes, jen.Const().Id(typeName).Op("=").Lit(def.typeName))
+			if def.Ephemeral != nil {
+				codes = append(codes, jen.Const().Id(ephemeralTypeName).Op("=").Lit(def.EntityTypeName(ephemeralType)))
+			}
 
 			// Even datasources require to set ID
 			codes = append(codes, genIDFields(def.typeName, root))
@@ -237,10 +237,10 @@ func genDefinition(doc *OpenAPIDoc, def *Definition) error {
 		}
 
 		if !resDat.DisableExample {
-			// Datasource examples are located in "data-source"
-			entityString := strings.ReplaceAll(entity.String(), "data", "data-")
+			// Datasource examples are located in "data-source", ephemeral in "ephemeral-resource"
+			entityString := entity.Kebab()
 
-			exampleDir := filepath.Join(examplesDir, entityString+"s", def.typeName)
+			exampleDir := filepath.Join(examplesDir, entityString+"s", def.EntityTypeName(entity))
 			err := os.MkdirAll(exampleDir, os.ModePerm)
 			if err != nil {
 				return fmt.Errorf("could not create directory %s: %w", exampleDir, err)
@@ -266,7 +266,7 @@ func genDefinition(doc *OpenAPIDoc, def *Definition) error {
 			if err := os.MkdirAll(docDir, os.ModePerm); err != nil {
 				return fmt.Errorf("could not create directory %s: %w", docDir, err)
 			}
-			docPath := filepath.Join(docDir, docFileName(def))
+			docPath := filepath.Join(docDir, docFileName(def, entity))
 			if err := os.WriteFile(docPath, docBytes, 0o644); err != nil {
 				return fmt.Errorf("could not write file %s: %w", docPath, err)
 			}
@@ -573,7 +573,7 @@ func newItem(scope *Scope, parent *Item, name string, s *OASchema, required bool
 		item.Name = newName
 	}
 
-	if slices.Contains(sensitiveFields, item.Name) {
+	if slices.Contains(sensitive.Fields, item.Name) {
 		item.Sensitive = true
 	}
 
@@ -10,7 +10,10 @@ func genEntityProvider(file *jen.File, entity entityType, definitions []*Definit
 	title := entity.Title()
 	values := make(jen.Dict)
 	for _, def := range definitions {
-		if entity.IsDataSource() && def.Datasource == nil || entity == resourceType && def.Resource == nil {
+		switch {
+		case entity.IsDataSource() && def.Datasource == nil,
+			entity.isResource() && def.Resource == nil,
+			entity.IsEphemeral() && def.Ephemeral == nil:
 			continue
 		}
 
@@ -22,7 +25,7 @@ func genEntityProvider(file *jen.File, entity entityType, definitions []*Definit
 		file.ImportName(p, goPkgName(p))
 
 		c := jen.Qual(adapterPackage, "NewLazy"+title).Call(jen.Qual(p, title+optionsSuffix))
-		values[jen.Lit(def.typeName)] = c
+		values[jen.Lit(def.EntityTypeName(entity))] = c
 	}
 
 	entityPkg := entity.Import(entityPackage)
@@ -32,3 +35,26 @@ func genEntityProvider(file *jen.File, entity entityType, definitions []*Definit
 		Add(returnType.Clone()).
 		Block(jen.Return(returnType.Clone().Values(values))).Line()
 }
+
+// genListProvider generates ListResources() for the resources with "list: true".
+// List resources share ResourceOptions with the resources.
+func genListProvider(file *jen.File, definitions []*Definition) {
+	values := make(jen.Dict)
+	for _, def := range definitions {
+		if def.Resource == nil || !def.Resource.List || def.ClientHandler == "" {
+			continue
+		}
+
+		p := filepath.Join(projectPackagePrefix, def.Location)
+		file.ImportName(p, goPkgName(p))
+
+		c := jen.Qual(adapterPackage, "NewLazyListResource").Call(jen.Qual(p, resourceType.Title()+optionsSuffix))
+		values[jen.Lit(def.EntityTypeName(resourceType))] = c
+	}
+
+	returnType := jen.Map(jen.String()).Func().Params().Qual(listPackage, "ListResource")
+	file.
+		Func().Id("ListResources").Params().
+		Add(returnType.Clone()).
+		Block(jen.Return(returnType.Clone().Values(values))).Line()
+}
@@ -29,7 +29,7 @@ func genSchema(def *Definition, entity entityType, item *Item) (jen.Code, error)
 	}
 
 	// Schema package depends on the entity type.
-	pkg := entityImport(entity.isResource(), schemaPackageFmt)
+	pkg := entity.Import(schemaPackageFmt)
 	funcName := string(entity) + schemaSuffix
 	return jen.
 		Line().
@@ -42,10 +42,10 @@ func genSchema(def *Definition, entity entityType, item *Item) (jen.Code, error)
 
 // genTimeoutsField generates the timeouts field for the schema.
 // The result depends on the entity type and whether legacy timeouts are used.
-func genTimeoutsField(isResource bool, def *Definition) jen.Code {
-	pkg := entityImport(isResource, timeoutsPackageFmt)
+func genTimeoutsField(entity entityType, def *Definition) jen.Code {
+	pkg := entity.Import(timeoutsPackageFmt)
 	method := "Block"
-	if isResource {
+	if entity.isResource() {
 		method = "BlockAll"
 		if def.LegacyTimeouts {
 			pkg = legacyTimeoutsPackage
@@ -99,7 +99,7 @@ func genAttributes(def *Definition, entity entityType, item *Item) (jen.Dict, er
 	}
 
 	if item.IsRoot() && def != nil {
-		blocks[jen.Lit("timeouts")] = genTimeoutsField(entity.isResource(), def)
+		blocks[jen.Lit("timeouts")] = genTimeoutsField(entity, def)
 	}
 
 	nested := make(jen.Dict)
@@ -287,10 +287,13 @@ func genSchemaInternal(def *Definition, entity entityType, item *Item) (jen.Code
 			timeout := s.Clone().Values(jen.Dict{
 				jen.Id("Type"): jen.Qual(adapterPackage, "SchemaTypeString"),
 			})
-			timeouts := jen.Dict{
-				jen.Lit("read"): timeout,
-			}
-			if entity.isResource() {
+			timeouts := jen.Dict{}
+			switch {
+			case entity.IsEphemeral():
+				// Ephemeral resources have the "open" operation only
+				timeouts[jen.Lit("open")] = timeout
+			case entity.isResource():
+				timeouts[jen.Lit("read")] = timeout
 				timeouts[jen.Lit("create")] = timeout
 				timeouts[jen.Lit("update")] = timeout
 				timeouts[jen.Lit("delete")] = timeout
@@ -298,6 +301,8 @@ func genSchemaInternal(def *Definition, entity entityType, item *Item) (jen.Code
 				if def.LegacyTimeouts {
 					timeouts[jen.Lit("default")] = timeout
 				}
+			default:
+				timeouts[jen.Lit("read")] = timeout
 			}
 
 			properties[jen.Lit("timeouts")] = s.Clone().Values(jen.Dict{
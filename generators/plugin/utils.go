@@ -160,6 +160,21 @@ func isValidRegex(pattern string) bool {
 	return err == nil
 }
 
+// entityConflictsWith returns the ConflictsWith fields without the ones in exactly.
+// The ephemeral resources don't have the write-only fields, so the conflicts with them are dropped.
+func entityConflictsWith(entity entityType, item *Item, exactly []string) []string {
+	conflicts := lo.Without(item.ConflictsWith, exactly...)
+	if !entity.IsEphemeral() || !item.IsRootProperty() {
+		return conflicts
+	}
+
+	props := item.Parent.PropertiesByEntity(entity)
+	return lo.Filter(conflicts, func(k string, _ int) bool {
+		_, ok := props[k]
+		return ok
+	})
+}
+
 func fmtDescription(def *Definition, entity entityType, item *Item) string {
 	description := normalizeDescription(item.Description, item.IsRoot())
 
@@ -173,7 +188,7 @@ func fmtDescription(def *Definition, entity entityType, item *Item) string {
 		b.MarkAsDataSource()
 		if item.IsRootProperty() {
 			switch {
-			case slices.Contains(def.Datasource.ExactlyOneOf, item.Name):
+			case entity.IsDataSource() && slices.Contains(def.Datasource.ExactlyOneOf, item.Name):
 				b.ExactlyOneOf(def.Datasource.ExactlyOneOf...)
 			case !item.FromSchemaOverride && def.DatasourceLookupHas(item.Name):
 				b.Lookup(def.DatasourceLookupID(), def.DatasourceLookupComposedOf()...)
@@ -230,10 +245,10 @@ func fmtDescription(def *Definition, entity entityType, item *Item) string {
 	// ExactlyOneOf already implies "not together", so suppress overlapping
 	// ConflictsWith. Mirrored in genValidators.
 	exactly := item.ExactlyOneOf
-	if !isResource && item.IsRootProperty() && slices.Contains(def.Datasource.ExactlyOneOf, item.Name) {
+	if entity.IsDataSource() && item.IsRootProperty() && slices.Contains(def.Datasource.ExactlyOneOf, item.Name) {
 		exactly = def.Datasource.ExactlyOneOf
 	}
-	if conflicts := lo.Without(item.ConflictsWith, exactly...); len(conflicts) > 0 {
+	if conflicts := entityConflictsWith(entity, item, exactly); len(conflicts) > 0 {
 		b.ConflictsWith(conflicts...)
 	}
 	if item.ExactlyOneOf != nil {
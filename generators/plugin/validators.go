@@ -98,11 +98,11 @@ func genValidators(def *Definition, entity entityType, item *Item) ([]jen.Code,
 	//
 	// Overlap with ExactlyOneOf is suppressed; mirrored in fmtDescription.
 	exactly := item.ExactlyOneOf
-	if !entity.isResource() && item.IsRootProperty() && slices.Contains(def.Datasource.ExactlyOneOf, item.Name) {
+	if entity.IsDataSource() && item.IsRootProperty() && slices.Contains(def.Datasource.ExactlyOneOf, item.Name) {
 		exactly = def.Datasource.ExactlyOneOf
 	}
 	validators := map[string][]string{
-		"ConflictsWith": lo.Without(item.ConflictsWith, exactly...),
+		"ConflictsWith": entityConflictsWith(entity, item, exactly),
 		"ExactlyOneOf":  item.ExactlyOneOf,
 		"AtLeastOneOf":  item.AtLeastOneOf,
 		"AlsoRequires":  item.AlsoRequires,
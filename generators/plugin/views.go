@@ -19,6 +19,7 @@ const (
 	planModifier                 = "planModifier"
 	validateConfig               = "validateConfig"
 	modifyPlan                   = "modifyPlan"
+	listView                     = "listView"
 	resourceData                 = "ResourceData"
 	renameFieldsModifier         = "RenameFields"
 	flattenModifier              = "flattenModifier"
@@ -63,6 +64,14 @@ func genViews(def *Definition, item *Item) ([]jen.Code, error) {
 		builders = append(builders, v)
 	}
 
+	if def.Ephemeral != nil {
+		v, err := genNewResource(ephemeralType, def, item, false)
+		if err != nil {
+			return nil, fmt.Errorf("ephemeral builder error: %w", err)
+		}
+		builders = append(builders, v)
+	}
+
 	return append(builders, codes...), nil
 }
 
@@ -81,7 +90,19 @@ func genNewResource(entity entityType, def *Definition, item *Item, hasConfigVal
 		values["Beta"] = jen.True()
 	}
 
+	if entity.IsEphemeral() {
+		values["TypeName"] = jen.Id(ephemeralTypeName)
+	}
+
 	for _, v := range def.Operations {
+		if entity.IsEphemeral() {
+			// Ephemeral resources reuse the read view to "open" the entity
+			if v.Type == OperationRead && !v.DisableView && !v.DatasourceLookup {
+				values["Open"] = jen.Id(fmt.Sprintf("%s%s", v.Type, viewSuffix))
+			}
+			continue
+		}
+
 		if entity.IsDataSource() {
 			if v.Type != OperationRead {
 				// Datasource only supports Read operation
@@ -209,6 +230,10 @@ func genNewResource(entity entityType, def *Definition, item *Item, hasConfigVal
 		if def.Resource.ModifyPlan {
 			values["ModifyPlan"] = jen.Id(modifyPlan)
 		}
+
+		if def.Resource.List {
+			values["List"] = jen.Id(listView)
+		}
 	}
 
 	if hasConfigValidators {
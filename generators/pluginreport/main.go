@@ -45,16 +45,18 @@ func report(w io.Writer) error {
 		return err
 	}
 
-	// Collect all resources and data sources, marking which ones use the plugin framework
+	// Collect all resources and data sources, counting which ones use the plugin framework.
+	// A name is migrated when both its resource and data source are, for instance,
+	// the service data sources are served by the plugin framework, but the resources are not.
 	allRes := make(map[string]int)
-	pluginRes := make(map[string]bool)
+	pluginRes := make(map[string]int)
 	for k := range plugin.ResourcesMap() {
 		allRes[k]++
-		pluginRes[k] = true
+		pluginRes[k]++
 	}
 	for k := range plugin.DataSourcesMap() {
 		allRes[k]++
-		pluginRes[k] = true
+		pluginRes[k]++
 	}
 	for k := range sdkProvider.ResourcesMap {
 		allRes[k]++
@@ -71,7 +73,7 @@ func report(w io.Writer) error {
 	for i, k := range sortedKeys(allRes) {
 		v := allRes[k]
 		var p string
-		if pluginRes[k] {
+		if pluginRes[k] == v {
 			p = "yes"
 			totalPlugin += v
 		} else {
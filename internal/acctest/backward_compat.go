@@ -40,6 +40,7 @@ func GetLastStableVersion() (string, error) {
 // For testing against a specific version of the Aiven provider.
 func ExternalAivenProvider(t *testing.T, version string) resource.ExternalProvider {
 	t.Helper()
+	SkipIfFakeAPI(t, "the published provider is downloaded from the registry")
 
 	if version == "" || strings.TrimSpace(version) == "latest" {
 		v, err := GetLastStableVersion()
@@ -106,6 +107,7 @@ type BackwardCompatConfig struct {
 // the plan MUST be empty (no changes needed).
 func BackwardCompatibilitySteps(t *testing.T, config BackwardCompatConfig) []resource.TestStep {
 	t.Helper()
+	SkipIfFakeAPI(t, "the published provider is downloaded from the registry")
 
 	version := config.OldProviderVersion
 	if version == "" {
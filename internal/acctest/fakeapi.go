@@ -0,0 +1,79 @@
+package acctest
+
+import (
+	"fmt"
+	"log"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/aiven/aiven-go-client/v2"
+	"github.com/samber/lo"
+
+	"github.com/aiven/terraform-provider-aiven/internal/fakeapi"
+)
+
+// envFakeAPI runs all acceptance tests against the local fake API, see startFakeAPI
+const envFakeAPI = "PROVIDER_AIVEN_FAKE_API"
+
+func init() {
+	if IsFakeAPI() {
+		startFakeAPI()
+	}
+}
+
+// IsFakeAPI returns true if the acceptance tests run against the local fake API.
+func IsFakeAPI() bool {
+	return os.Getenv(envFakeAPI) != ""
+}
+
+// SkipIfFakeAPI skips the test that needs what the fake API does not cover.
+func SkipIfFakeAPI(t *testing.T, reason string) {
+	t.Helper()
+
+	if IsFakeAPI() {
+		t.Skipf("Unset '%s' to run this test: %s", envFakeAPI, reason)
+	}
+}
+
+// startFakeAPI starts the fake API for the test binary and points the clients to it with the environment variables,
+// so the provider, TestProtoV6ProviderFactories and the test clients use it with no changes to the tests.
+// The project is AIVEN_PROJECT_NAME or "test-acc".
+func startFakeAPI() {
+	projectName := lo.CoalesceOrEmpty(os.Getenv(envProjectName), DefaultResourceNamePrefix)
+
+	// Lives until the test binary exits
+	s := httptest.NewServer(fakeapi.New(fakeapi.WithProject(projectName)))
+	env := map[string]string{
+		"AIVEN_WEB_URL": s.URL,
+		envToken:        "fake",
+		envProjectName:  projectName,
+	}
+	for k, v := range env {
+		if err := os.Setenv(k, v); err != nil {
+			log.Panicf("error setting %s: %s", k, err)
+		}
+	}
+
+	// The handwritten client reads AIVEN_WEB_URL on init, before this package, SetupEnvClient reads it again
+	if _, err := aiven.SetupEnvClient("acc-tests"); err != nil {
+		log.Panicf("fake API client error: %s", err)
+	}
+}
+
+// FakeAPIProvider starts the local fake API with the project,
+// and returns the provider block that sends the requests to it.
+// The tests that use it need neither AIVEN_TOKEN nor network access, see the fakeapi package for the covered endpoints.
+func FakeAPIProvider(t *testing.T, projectName string) string {
+	t.Helper()
+
+	s := httptest.NewServer(fakeapi.New(fakeapi.WithProject(projectName)))
+	t.Cleanup(s.Close)
+
+	return fmt.Sprintf(`
+provider "aiven" {
+  api_token = "fake"
+  api_url   = %q
+}
+`, s.URL)
+}
@@ -47,7 +47,10 @@ type ServicePasswordTestOptions struct {
 
 // TestAccCheckAivenServiceWriteOnlyPassword tests the full lifecycle of write-only passwords
 func TestAccCheckAivenServiceWriteOnlyPassword(t *testing.T, opts ServicePasswordTestOptions) {
-	t.Skip("This test will be enabled once services support write-only passwords")
+	serviceType := strings.TrimPrefix(opts.ResourceType, "aiven_")
+	if !schemautil.SupportsWriteOnlyPassword(serviceType) {
+		t.Skipf("%s does not support write-only passwords", opts.ResourceType)
+	}
 
 	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 	resourceName := fmt.Sprintf("%s.test", opts.ResourceType)
@@ -2,8 +2,11 @@ package common
 
 import (
 	"context"
+	"crypto/x509"
 	"fmt"
+	"net/url"
 	"os"
+	"strconv"
 	"sync"
 	"time"
 
@@ -27,7 +30,12 @@ func NewAivenClient(opts ...ClientOpt) (*aiven.Client, error) {
 	if err != nil {
 		return nil, err
 	}
-	return aiven.NewTokenClient(o.token, o.userAgent)
+	client, err := aiven.NewTokenClient(o.token, o.userAgent)
+	if err != nil {
+		return nil, err
+	}
+	client.Client = newHTTPClient(o)
+	return client, nil
 }
 
 // NewAivenGenClient Returns generated client
@@ -36,14 +44,20 @@ func NewAivenGenClient(opts ...ClientOpt) (avngen.Client, error) {
 	if err != nil {
 		return nil, err
 	}
-	return avngen.NewClient(avngen.TokenOpt(o.token), avngen.UserAgentOpt(o.userAgent))
+	return avngen.NewClient(
+		avngen.TokenOpt(o.token),
+		avngen.UserAgentOpt(o.userAgent),
+		avngen.DoerOpt(newHTTPClient(o)),
+	)
 }
 
 var (
 	genClientCache      avngen.Client
 	genClientCacheError error
 	genClientCacheOnce  sync.Once
-	errTokenRequired    = fmt.Errorf("token is required for Aiven client")
+
+	// ErrTokenRequired is returned when none of the token options is set.
+	ErrTokenRequired = fmt.Errorf("token is required for Aiven client")
 )
 
 // CachedGenAivenClient runs once
@@ -105,27 +119,57 @@ type (
 	ClientOpt  func(o *clientOpts)
 	clientOpts struct {
 		token        string
+		tokenFile    string
+		tokenCommand string
 		tfVersion    string // User-Agent part: TF CLI version
 		buildVersion string // User-Agent part: Aiven Provider build version
 		userAgent    string
+
+		// HTTP settings, see newHTTPClient
+		apiURL         *url.URL
+		maxRetries     *int
+		retryBackoff   time.Duration
+		requestTimeout time.Duration
+		httpProxy      *url.URL
+		rateLimit      float64        // requests per second
+		rootCAs        *x509.CertPool // the system pool with AIVEN_CA_CERT
+
+		// tokenSource reads the token from the tokenFile or the tokenCommand
+		tokenSource *tokenSource
 	}
 )
 
 func newClientOpts(opts ...ClientOpt) (*clientOpts, error) {
 	o := &clientOpts{
-		token: os.Getenv("AIVEN_TOKEN"),
+		token:        os.Getenv("AIVEN_TOKEN"),
+		tokenFile:    os.Getenv("AIVEN_TOKEN_FILE"),
+		tokenCommand: os.Getenv("AIVEN_TOKEN_COMMAND"),
 		// Terraform 0.12 introduced this field to the protocol
 		// We can therefore assume that if it's missing, it's 0.10 or 0.11
 		tfVersion:    "0.11+compatible",
 		buildVersion: "dev",
 	}
 
+	err := o.loadEnv()
+	if err != nil {
+		return nil, err
+	}
+
 	for _, v := range opts {
 		v(o)
 	}
 
-	if o.token == "" {
-		return nil, errTokenRequired
+	err = o.loadToken()
+	if err != nil {
+		return nil, err
+	}
+
+	if o.maxRetries != nil && *o.maxRetries < 0 {
+		return nil, fmt.Errorf("max_retries must be non-negative, got %d", *o.maxRetries)
+	}
+
+	if o.rateLimit < 0 {
+		return nil, fmt.Errorf("rate_limit must be non-negative, got %v", o.rateLimit)
 	}
 
 	o.userAgent = fmt.Sprintf("terraform-provider-aiven/%s/%s", o.tfVersion, o.buildVersion)
@@ -146,8 +190,152 @@ func BuildVersionOpt(v string) ClientOpt {
 	}
 }
 
+// TokenOpt API token, overrides the other token options and the environment variables
 func TokenOpt(v string) ClientOpt {
 	return func(o *clientOpts) {
-		o.token = v
+		o.token, o.tokenFile, o.tokenCommand = v, "", ""
+	}
+}
+
+// TokenFileOpt path to a file with the API token, the file is read again when the API responds with 401
+func TokenFileOpt(v string) ClientOpt {
+	return func(o *clientOpts) {
+		o.token, o.tokenFile, o.tokenCommand = "", v, ""
+	}
+}
+
+// TokenCommandOpt command that prints the API token, it runs again when the API responds with 401
+func TokenCommandOpt(v string) ClientOpt {
+	return func(o *clientOpts) {
+		o.token, o.tokenFile, o.tokenCommand = "", "", v
+	}
+}
+
+// loadToken reads the token from the first set option: the token, the token file or the token command.
+func (o *clientOpts) loadToken() error {
+	var err error
+	switch {
+	case o.token != "":
+		return nil
+	case o.tokenFile != "":
+		o.tokenSource, err = newTokenFileSource(context.Background(), o.tokenFile)
+	case o.tokenCommand != "":
+		o.tokenSource, err = newTokenCommandSource(context.Background(), o.tokenCommand)
+	default:
+		return ErrTokenRequired
+	}
+
+	if err != nil {
+		return err
+	}
+
+	// The clients require a token, the tokenTransport replaces it with the current one
+	o.token = o.tokenSource.Token()
+	return nil
+}
+
+// loadEnv reads the HTTP settings from the environment variables.
+// The AIVEN_WEB_URL is read by the clients.
+func (o *clientOpts) loadEnv() error {
+	if v := os.Getenv("AIVEN_MAX_RETRIES"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("invalid AIVEN_MAX_RETRIES: %w", err)
+		}
+		o.maxRetries = &n
+	}
+
+	durations := map[string]*time.Duration{
+		"AIVEN_RETRY_BACKOFF":   &o.retryBackoff,
+		"AIVEN_REQUEST_TIMEOUT": &o.requestTimeout,
+	}
+	for k, d := range durations {
+		if v := os.Getenv(k); v != "" {
+			var err error
+			*d, err = time.ParseDuration(v)
+			if err != nil {
+				return fmt.Errorf("invalid %s: %w", k, err)
+			}
+		}
+	}
+
+	if v := os.Getenv("AIVEN_HTTP_PROXY"); v != "" {
+		u, err := ParseURL(v)
+		if err != nil {
+			return fmt.Errorf("invalid AIVEN_HTTP_PROXY: %w", err)
+		}
+		o.httpProxy = u
+	}
+
+	if v := os.Getenv("AIVEN_CA_CERT"); v != "" {
+		pool, err := loadCACert(v)
+		if err != nil {
+			return fmt.Errorf("invalid AIVEN_CA_CERT: %w", err)
+		}
+		o.rootCAs = pool
+	}
+
+	if v := os.Getenv("AIVEN_RATE_LIMIT"); v != "" {
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return fmt.Errorf("invalid AIVEN_RATE_LIMIT: %w", err)
+		}
+		o.rateLimit = f
+	}
+	return nil
+}
+
+// ParseURL parses an absolute URL, like "https://api.aiven.io" or "http://localhost:8080".
+func ParseURL(v string) (*url.URL, error) {
+	u, err := url.Parse(v)
+	if err != nil {
+		return nil, err
+	}
+
+	if u.Scheme == "" || u.Host == "" {
+		return nil, fmt.Errorf("%q must be an absolute URL with a scheme and a host", v)
+	}
+	return u, nil
+}
+
+// APIURLOpt Aiven API base URL, for instance, a local API stand-in
+func APIURLOpt(v *url.URL) ClientOpt {
+	return func(o *clientOpts) {
+		o.apiURL = v
+	}
+}
+
+// MaxRetriesOpt maximum number of retries of a failed request
+func MaxRetriesOpt(v int) ClientOpt {
+	return func(o *clientOpts) {
+		o.maxRetries = &v
+	}
+}
+
+// RetryBackoffOpt minimum wait time between retries, grows exponentially
+func RetryBackoffOpt(v time.Duration) ClientOpt {
+	return func(o *clientOpts) {
+		o.retryBackoff = v
+	}
+}
+
+// RequestTimeoutOpt timeout of a single HTTP request attempt
+func RequestTimeoutOpt(v time.Duration) ClientOpt {
+	return func(o *clientOpts) {
+		o.requestTimeout = v
+	}
+}
+
+// HTTPProxyOpt HTTP proxy URL
+func HTTPProxyOpt(v *url.URL) ClientOpt {
+	return func(o *clientOpts) {
+		o.httpProxy = v
+	}
+}
+
+// RateLimitOpt client-side limit of requests per second
+func RateLimitOpt(v float64) ClientOpt {
+	return func(o *clientOpts) {
+		o.rateLimit = v
 	}
 }
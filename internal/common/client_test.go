@@ -1,8 +1,12 @@
 package common
 
 import (
+	"errors"
+	"net/url"
 	"testing"
+	"time"
 
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -11,12 +15,13 @@ func TestNewClientOpts(t *testing.T) {
 		name      string
 		opts      []ClientOpt
 		envToken  string
+		env       map[string]string
 		expectErr error
 		expect    *clientOpts
 	}{
 		{
 			name:      "empty options",
-			expectErr: errTokenRequired,
+			expectErr: ErrTokenRequired,
 		},
 		{
 			name:     "env token",
@@ -80,11 +85,68 @@ func TestNewClientOpts(t *testing.T) {
 				userAgent:    "terraform-provider-aiven/bar/baz",
 			},
 		},
+		{
+			name:     "http options from env",
+			envToken: "foo",
+			env: map[string]string{
+				"AIVEN_MAX_RETRIES":     "3",
+				"AIVEN_RETRY_BACKOFF":   "2s",
+				"AIVEN_REQUEST_TIMEOUT": "1m",
+				"AIVEN_HTTP_PROXY":      "http://proxy:3128",
+				"AIVEN_RATE_LIMIT":      "2.5",
+			},
+			expect: &clientOpts{
+				token:          "foo",
+				tfVersion:      "0.11+compatible",
+				buildVersion:   "dev",
+				userAgent:      "terraform-provider-aiven/0.11+compatible/dev",
+				maxRetries:     lo.ToPtr(3),
+				retryBackoff:   2 * time.Second,
+				requestTimeout: time.Minute,
+				httpProxy:      &url.URL{Scheme: "http", Host: "proxy:3128"},
+				rateLimit:      2.5,
+			},
+		},
+		{
+			name:     "http options override env",
+			envToken: "foo",
+			env: map[string]string{
+				"AIVEN_MAX_RETRIES": "3",
+				"AIVEN_RATE_LIMIT":  "2.5",
+			},
+			opts: []ClientOpt{
+				APIURLOpt(&url.URL{Scheme: "http", Host: "localhost:8080"}),
+				MaxRetriesOpt(0),
+				RateLimitOpt(10),
+			},
+			expect: &clientOpts{
+				token:        "foo",
+				tfVersion:    "0.11+compatible",
+				buildVersion: "dev",
+				userAgent:    "terraform-provider-aiven/0.11+compatible/dev",
+				apiURL:       &url.URL{Scheme: "http", Host: "localhost:8080"},
+				maxRetries:   lo.ToPtr(0),
+				rateLimit:    10,
+			},
+		},
+		{
+			name:      "negative max retries",
+			envToken:  "foo",
+			opts:      []ClientOpt{MaxRetriesOpt(-1)},
+			expectErr: errors.New("max_retries must be non-negative, got -1"),
+		},
 	}
 
 	for _, o := range cases {
 		t.Run(o.name, func(t *testing.T) {
 			t.Setenv("AIVEN_TOKEN", o.envToken) // must not expose a real token in logs
+			envKeys := []string{
+				"AIVEN_TOKEN_FILE", "AIVEN_TOKEN_COMMAND",
+				"AIVEN_MAX_RETRIES", "AIVEN_RETRY_BACKOFF", "AIVEN_REQUEST_TIMEOUT", "AIVEN_HTTP_PROXY", "AIVEN_RATE_LIMIT",
+			}
+			for _, k := range envKeys {
+				t.Setenv(k, o.env[k])
+			}
 			actual, err := newClientOpts(o.opts...)
 			assert.Equal(t, o.expectErr, err)
 			assert.Equal(t, o.expect, actual)
@@ -0,0 +1,29 @@
+package common
+
+import (
+	"sync"
+)
+
+var (
+	// costWarningThreshold is the provider cost_warning_threshold, nil when it is not set.
+	// Both providers are configured with the same config, so they set the same value.
+	costWarningThreshold   *float64
+	costWarningThresholdMu sync.RWMutex
+)
+
+// SetCostWarningThreshold sets the provider cost_warning_threshold, nil disables the warnings.
+func SetCostWarningThreshold(v *float64) {
+	costWarningThresholdMu.Lock()
+	defer costWarningThresholdMu.Unlock()
+	costWarningThreshold = v
+}
+
+// CostWarningThreshold returns the provider cost_warning_threshold, false if it is not set.
+func CostWarningThreshold() (float64, bool) {
+	costWarningThresholdMu.RLock()
+	defer costWarningThresholdMu.RUnlock()
+	if costWarningThreshold == nil {
+		return 0, false
+	}
+	return *costWarningThreshold, true
+}
@@ -1,10 +1,29 @@
 package common
 
 import (
+	"errors"
+	"fmt"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/aiven/terraform-provider-aiven/internal/plugin/errmsg"
 )
 
+// ClientErrorMessage returns the summary and the detail of the error returned by NewAivenClient or NewAivenGenClient.
+// Used by both providers, so the errors look the same.
+func ClientErrorMessage(err error) (summary, detail string) {
+	var tokenErr *TokenSourceError
+	switch {
+	case errors.Is(err, ErrTokenRequired):
+		return errmsg.SummaryTokenMissing, errmsg.DetailTokenMissing
+	case errors.As(err, &tokenErr):
+		return errmsg.SummaryTokenRead, fmt.Sprintf(errmsg.DetailTokenRead, tokenErr.Source, tokenErr.Err)
+	default:
+		return errmsg.SummaryConstructingClient, err.Error()
+	}
+}
+
 // CheckDeprecatedTimeoutDefault adds a warning diagnostic if timeouts.default is configured during apply operations.
 // During plan refresh, GetRawConfig returns null, so warning won't be shown.
 func CheckDeprecatedTimeoutDefault(d *schema.ResourceData) diag.Diagnostic {
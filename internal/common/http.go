@@ -0,0 +1,259 @@
+package common
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/samber/lo"
+)
+
+const (
+	// defaultWebURL is the URL both clients send requests to, unless AIVEN_WEB_URL is set.
+	defaultWebURL = "https://api.aiven.io"
+
+	// defaultMaxRetries, defaultRetryBackoff and defaultRetryBackoffMax match the handwritten client defaults.
+	defaultMaxRetries      = 10
+	defaultRetryBackoff    = 1 * time.Second
+	defaultRetryBackoffMax = 30 * time.Second
+)
+
+// newHTTPClient builds a retryable HTTP client shared by the handwritten and the generated clients.
+// It keeps the retry policy and the AIVEN_CA_CERT support of the handwritten client, see checkRetry.
+// Retries are limited by the rate limiter too, so 429s do not make things worse.
+func newHTTPClient(o *clientOpts) *http.Client {
+	transport := cleanhttp.DefaultPooledTransport()
+	if o.httpProxy != nil {
+		transport.Proxy = http.ProxyURL(o.httpProxy)
+	}
+
+	if o.rootCAs != nil {
+		transport.TLSClientConfig = &tls.Config{RootCAs: o.rootCAs, MinVersion: tls.VersionTLS12}
+	}
+
+	// Logs each attempt, including retries
+	var rt http.RoundTripper = &logTransport{next: transport}
+	if o.rateLimit > 0 {
+		rt = &rateLimitTransport{next: rt, limiter: sharedRateLimiter(o.rateLimit)}
+	}
+
+	if o.apiURL != nil {
+		// Both clients read AIVEN_WEB_URL on their own, the api_url takes precedence.
+		webURL, err := url.Parse(lo.CoalesceOrEmpty(os.Getenv("AIVEN_WEB_URL"), defaultWebURL))
+		if err == nil {
+			rt = &apiURLTransport{next: rt, webURL: webURL, apiURL: o.apiURL}
+		}
+	}
+
+	if o.tokenSource != nil {
+		rt = &tokenTransport{next: rt, source: o.tokenSource}
+	}
+
+	retryClient := retryablehttp.NewClient()
+	retryClient.Logger = nil
+	retryClient.HTTPClient.Transport = rt
+	retryClient.HTTPClient.Timeout = o.requestTimeout
+	retryClient.CheckRetry = checkRetry
+	retryClient.RetryMax = defaultMaxRetries
+	if o.maxRetries != nil {
+		retryClient.RetryMax = *o.maxRetries
+	}
+
+	// The default backoff respects the Retry-After header of 429 and 503 responses.
+	retryClient.RetryWaitMin = defaultRetryBackoff
+	retryClient.RetryWaitMax = defaultRetryBackoffMax
+	if o.retryBackoff > 0 {
+		retryClient.RetryWaitMin = o.retryBackoff
+		retryClient.RetryWaitMax = max(o.retryBackoff, defaultRetryBackoffMax)
+	}
+
+	return retryClient.StandardClient()
+}
+
+// checkRetry is the retry policy of the handwritten client:
+// retries the connection errors and the 5xx except 501, like retryablehttp does, and the responses in isRetryable.
+func checkRetry(ctx context.Context, rsp *http.Response, err error) (bool, error) {
+	shouldRetry, err := retryablehttp.ErrorPropagatedRetryPolicy(ctx, rsp, err)
+	return shouldRetry || err == nil && isRetryable(rsp), nil
+}
+
+// isRetryable
+// 501 — some resources like kafka topic and kafka connector may return 501. Which means retry later
+// 417 — has dependent resource pending: Application deletion forbidden because it has 1 deployment(s).
+// 408 — dependent server time out
+// 404 — see retryableChecks
+func isRetryable(rsp *http.Response) bool {
+	// This might happen in tests only
+	if rsp.Request == nil {
+		return false
+	}
+
+	switch rsp.StatusCode {
+	case http.StatusRequestTimeout, http.StatusNotImplemented:
+		return true
+	case http.StatusExpectationFailed:
+		return rsp.Request.Method == http.MethodDelete
+	case http.StatusNotFound:
+		// Shouldn't be there much of data, ReadAll is ok
+		b, err := io.ReadAll(rsp.Body)
+		rsp.Body.Close()
+
+		// Restores the body
+		rsp.Body = io.NopCloser(bytes.NewReader(b))
+		if err != nil {
+			return false
+		}
+
+		s := string(b)
+		for _, c := range retryableChecks {
+			if c(rsp.Request.Method, s) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+var retryableChecks = []func(meth, body string) bool{
+	isServiceLagError,
+	isUserError,
+}
+
+var (
+	reServiceNotFound = regexp.MustCompile(`Service \S+ does not exist`)
+	reUserNotFound    = regexp.MustCompile(`User (avnadmin|root) with component main not found`)
+)
+
+// isServiceLagError the service might be ready, but there is a lag to wait for
+func isServiceLagError(meth, body string) bool {
+	return meth == http.MethodPost && reServiceNotFound.MatchString(body)
+}
+
+// isUserError an internal unknown error
+func isUserError(_, body string) bool {
+	return reUserNotFound.MatchString(body)
+}
+
+// loadCACert returns the system cert pool with the CA certificate from the file
+func loadCACert(filename string) (*x509.CertPool, error) {
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	pool, _ := x509.SystemCertPool()
+	if pool == nil {
+		pool = x509.NewCertPool()
+	}
+
+	if !pool.AppendCertsFromPEM(b) {
+		return nil, fmt.Errorf("no certificates found in %s", filename)
+	}
+	return pool, nil
+}
+
+// apiURLTransport sends the requests addressed to the webURL to the apiURL.
+// The handwritten client has no option for that, and reads AIVEN_WEB_URL once on init.
+type apiURLTransport struct {
+	next   http.RoundTripper
+	webURL *url.URL
+	apiURL *url.URL
+}
+
+func (t *apiURLTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.URL.Host != t.webURL.Host || !strings.HasPrefix(req.URL.Path, t.webURL.Path) {
+		return t.next.RoundTrip(req)
+	}
+
+	// RoundTrip must not modify the original request
+	req = req.Clone(req.Context())
+	req.URL.Scheme = t.apiURL.Scheme
+	req.URL.Host = t.apiURL.Host
+	req.URL.Path = path.Join("/", t.apiURL.Path, strings.TrimPrefix(req.URL.Path, t.webURL.Path))
+	req.URL.RawPath = ""
+	req.Host = t.apiURL.Host
+	return t.next.RoundTrip(req)
+}
+
+// rateLimitTransport waits for the rate limiter before sending each request.
+type rateLimitTransport struct {
+	next    http.RoundTripper
+	limiter *rateLimiter
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.limiter.Wait(req.Context()); err != nil {
+		return nil, err
+	}
+	return t.next.RoundTrip(req)
+}
+
+// rateLimiter allows one event per interval.
+// Requests are spread evenly, there are no bursts.
+type rateLimiter struct {
+	mu       sync.Mutex
+	interval time.Duration
+	next     time.Time
+}
+
+func newRateLimiter(perSecond float64) *rateLimiter {
+	return &rateLimiter{interval: time.Duration(float64(time.Second) / perSecond)}
+}
+
+var (
+	rateLimitersMu sync.Mutex
+	rateLimiters   = make(map[float64]*rateLimiter)
+)
+
+// sharedRateLimiter returns the process-wide limiter for the given rate.
+// The provider builds several clients, they must share the limit.
+func sharedRateLimiter(perSecond float64) *rateLimiter {
+	rateLimitersMu.Lock()
+	defer rateLimitersMu.Unlock()
+
+	l, ok := rateLimiters[perSecond]
+	if !ok {
+		l = newRateLimiter(perSecond)
+		rateLimiters[perSecond] = l
+	}
+	return l
+}
+
+// Wait blocks until the next event is allowed or the context is done.
+func (l *rateLimiter) Wait(ctx context.Context) error {
+	l.mu.Lock()
+	now := time.Now()
+	at := l.next
+	if at.Before(now) {
+		at = now
+	}
+	l.next = at.Add(l.interval)
+	l.mu.Unlock()
+
+	delay := at.Sub(now)
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,198 @@
+package common
+
+import (
+	"context"
+	"encoding/pem"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestAPIURLTransport(t *testing.T) {
+	cases := []struct {
+		name   string
+		webURL string
+		apiURL string
+		input  string
+		expect string
+	}{
+		{
+			name:   "default web url",
+			webURL: "https://api.aiven.io",
+			apiURL: "http://localhost:8080",
+			input:  "https://api.aiven.io/v1/project/foo",
+			expect: "http://localhost:8080/v1/project/foo",
+		},
+		{
+			name:   "api url with a path",
+			webURL: "https://api.aiven.io",
+			apiURL: "http://localhost:8080/aiven",
+			input:  "https://api.aiven.io/v1/project/foo?limit=1",
+			expect: "http://localhost:8080/aiven/v1/project/foo?limit=1",
+		},
+		{
+			name:   "custom web url",
+			webURL: "https://example.com/api",
+			apiURL: "http://localhost:8080",
+			input:  "https://example.com/api/v1/project/foo",
+			expect: "http://localhost:8080/v1/project/foo",
+		},
+		{
+			name:   "other host",
+			webURL: "https://api.aiven.io",
+			apiURL: "http://localhost:8080",
+			input:  "https://example.com/v1/project/foo",
+			expect: "https://example.com/v1/project/foo",
+		},
+	}
+
+	for _, o := range cases {
+		t.Run(o.name, func(t *testing.T) {
+			var actual string
+			rt := &apiURLTransport{
+				webURL: mustParseURL(t, o.webURL),
+				apiURL: mustParseURL(t, o.apiURL),
+				next: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+					actual = req.URL.String()
+					return &http.Response{StatusCode: http.StatusOK}, nil
+				}),
+			}
+
+			req, err := http.NewRequest(http.MethodGet, o.input, nil)
+			require.NoError(t, err)
+			_, err = rt.RoundTrip(req)
+			require.NoError(t, err)
+			assert.Equal(t, o.expect, actual)
+			assert.Equal(t, o.input, req.URL.String(), "the original request must not be modified")
+		})
+	}
+}
+
+func TestRateLimiter(t *testing.T) {
+	l := newRateLimiter(100) // 10ms interval
+	ctx := context.Background()
+	start := time.Now()
+	for range 5 {
+		require.NoError(t, l.Wait(ctx))
+	}
+	assert.GreaterOrEqual(t, time.Since(start), 40*time.Millisecond)
+
+	// The next event is scheduled in the future, the canceled context must not wait for it.
+	ctx, cancel := context.WithCancel(ctx)
+	cancel()
+	assert.ErrorIs(t, l.Wait(ctx), context.Canceled)
+}
+
+func TestSharedRateLimiter(t *testing.T) {
+	a := newHTTPClient(&clientOpts{rateLimit: 3})
+	b := newHTTPClient(&clientOpts{rateLimit: 3})
+	c := newHTTPClient(&clientOpts{rateLimit: 5})
+
+	// Both clients must count against the same limit
+	assert.Same(t, clientRateLimiter(t, a), clientRateLimiter(t, b))
+	assert.NotSame(t, clientRateLimiter(t, a), clientRateLimiter(t, c))
+}
+
+// clientRateLimiter returns the limiter of the client built by newHTTPClient
+func clientRateLimiter(t *testing.T, c *http.Client) *rateLimiter {
+	t.Helper()
+	rt, ok := c.Transport.(*retryablehttp.RoundTripper)
+	require.True(t, ok)
+	limit, ok := rt.Client.HTTPClient.Transport.(*rateLimitTransport)
+	require.True(t, ok)
+	return limit.limiter
+}
+
+func TestCheckRetry(t *testing.T) {
+	cases := []struct {
+		name   string
+		method string
+		status int
+		body   string
+		expect bool
+	}{
+		{name: "ok", method: http.MethodGet, status: http.StatusOK, expect: false},
+		{name: "server error", method: http.MethodGet, status: http.StatusInternalServerError, expect: true},
+		{name: "not implemented", method: http.MethodPost, status: http.StatusNotImplemented, expect: true},
+		{name: "request timeout", method: http.MethodGet, status: http.StatusRequestTimeout, expect: true},
+		{name: "expectation failed on delete", method: http.MethodDelete, status: http.StatusExpectationFailed, expect: true},
+		{name: "expectation failed on get", method: http.MethodGet, status: http.StatusExpectationFailed, expect: false},
+		{name: "service lag", method: http.MethodPost, status: http.StatusNotFound, body: `{"message": "Service foo does not exist"}`, expect: true},
+		{name: "service lag on get", method: http.MethodGet, status: http.StatusNotFound, body: `{"message": "Service foo does not exist"}`, expect: false},
+		{name: "user not found", method: http.MethodGet, status: http.StatusNotFound, body: `{"message": "User avnadmin with component main not found"}`, expect: true},
+		{name: "not found", method: http.MethodGet, status: http.StatusNotFound, body: `{"message": "Not found"}`, expect: false},
+	}
+
+	for _, o := range cases {
+		t.Run(o.name, func(t *testing.T) {
+			req, err := http.NewRequest(o.method, "https://api.aiven.io/v1/project/foo", nil)
+			require.NoError(t, err)
+
+			rsp := &http.Response{
+				StatusCode: o.status,
+				Request:    req,
+				Body:       io.NopCloser(strings.NewReader(o.body)),
+			}
+			actual, err := checkRetry(context.Background(), rsp, nil)
+			require.NoError(t, err)
+			assert.Equal(t, o.expect, actual)
+
+			// The body must be readable after the check
+			b, err := io.ReadAll(rsp.Body)
+			require.NoError(t, err)
+			assert.Equal(t, o.body, string(b))
+		})
+	}
+}
+
+func TestCACert(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	// Without the CA, the certificate is unknown
+	c := newHTTPClient(&clientOpts{maxRetries: lo.ToPtr(0)})
+	_, err := c.Get(srv.URL)
+	require.Error(t, err)
+
+	filename := filepath.Join(t.TempDir(), "ca.pem")
+	b := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+	require.NoError(t, os.WriteFile(filename, b, 0o600))
+	t.Setenv("AIVEN_CA_CERT", filename)
+
+	o := new(clientOpts)
+	require.NoError(t, o.loadEnv())
+	rsp, err := newHTTPClient(o).Get(srv.URL)
+	require.NoError(t, err)
+	rsp.Body.Close()
+	assert.Equal(t, http.StatusOK, rsp.StatusCode)
+
+	// Not a certificate
+	require.NoError(t, os.WriteFile(filename, []byte("foo"), 0o600))
+	assert.ErrorContains(t, o.loadEnv(), "invalid AIVEN_CA_CERT: no certificates found")
+}
+
+func mustParseURL(t *testing.T, v string) *url.URL {
+	t.Helper()
+	u, err := url.Parse(v)
+	require.NoError(t, err)
+	return u
+}
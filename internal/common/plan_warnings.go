@@ -0,0 +1,86 @@
+package common
+
+import (
+	"context"
+	"slices"
+	"sync"
+)
+
+// PlanWarning is a warning diagnostic added to the plan of a resource
+type PlanWarning struct {
+	Summary string
+	Detail  string
+}
+
+// PlanAttributeError is an error diagnostic of a map key of the resource attribute, for instance, config["batch.size"]
+type PlanAttributeError struct {
+	Attribute string
+	Key       string
+	Summary   string
+	Detail    string
+}
+
+// PlanWarnings collects the warnings of the SDK CustomizeDiff functions, which can return errors only.
+// It also collects the attribute errors, the SDK errors have no attribute path.
+type PlanWarnings struct {
+	mu       sync.Mutex
+	warnings []PlanWarning
+	errors   []PlanAttributeError
+}
+
+type planWarningsKey struct{}
+
+// WithPlanWarnings returns a context that collects the warnings added with AddPlanWarning
+// and the errors added with AddPlanAttributeError.
+func WithPlanWarnings(ctx context.Context) (context.Context, *PlanWarnings) {
+	w := new(PlanWarnings)
+	return context.WithValue(ctx, planWarningsKey{}, w), w
+}
+
+// AddPlanWarning adds a warning to the plan.
+// The SDK might run CustomizeDiff more than once, so the same warning is added once.
+// Does nothing if the context doesn't collect the warnings.
+func AddPlanWarning(ctx context.Context, summary, detail string) {
+	w, ok := ctx.Value(planWarningsKey{}).(*PlanWarnings)
+	if !ok {
+		return
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	warning := PlanWarning{Summary: summary, Detail: detail}
+	if !slices.Contains(w.warnings, warning) {
+		w.warnings = append(w.warnings, warning)
+	}
+}
+
+// AddPlanAttributeError adds an error of the map key of the attribute to the plan.
+// CustomizeDiff must still return an error to fail the plan.
+// Does nothing if the context doesn't collect the errors.
+func AddPlanAttributeError(ctx context.Context, attribute, key, summary, detail string) {
+	w, ok := ctx.Value(planWarningsKey{}).(*PlanWarnings)
+	if !ok {
+		return
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	e := PlanAttributeError{Attribute: attribute, Key: key, Summary: summary, Detail: detail}
+	if !slices.Contains(w.errors, e) {
+		w.errors = append(w.errors, e)
+	}
+}
+
+// List returns the collected warnings
+func (w *PlanWarnings) List() []PlanWarning {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return slices.Clone(w.warnings)
+}
+
+// Errors returns the collected attribute errors
+func (w *PlanWarnings) Errors() []PlanAttributeError {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return slices.Clone(w.errors)
+}
@@ -0,0 +1,41 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlanWarnings(t *testing.T) {
+	// Does nothing without the collector
+	AddPlanWarning(t.Context(), "foo", "bar")
+
+	ctx, warnings := WithPlanWarnings(t.Context())
+	AddPlanWarning(ctx, "foo", "bar")
+	AddPlanWarning(ctx, "foo", "baz")
+
+	// CustomizeDiff might run twice
+	AddPlanWarning(ctx, "foo", "bar")
+
+	expected := []PlanWarning{
+		{Summary: "foo", Detail: "bar"},
+		{Summary: "foo", Detail: "baz"},
+	}
+	assert.Equal(t, expected, warnings.List())
+}
+
+func TestPlanAttributeErrors(t *testing.T) {
+	// Does nothing without the collector
+	AddPlanAttributeError(t.Context(), "config", "foo", "Invalid", "bar")
+
+	ctx, warnings := WithPlanWarnings(t.Context())
+	AddPlanAttributeError(ctx, "config", "batch.size", "Invalid", "Not a number")
+	AddPlanAttributeError(ctx, "config", "batch.size", "Invalid", "Not a number")
+	AddPlanWarning(ctx, "foo", "bar")
+
+	expected := []PlanAttributeError{
+		{Attribute: "config", Key: "batch.size", Summary: "Invalid", Detail: "Not a number"},
+	}
+	assert.Equal(t, expected, warnings.Errors())
+	assert.Len(t, warnings.List(), 1)
+}
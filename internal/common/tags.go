@@ -0,0 +1,54 @@
+package common
+
+import (
+	"maps"
+	"sync"
+)
+
+var (
+	// defaultTags are the provider default_tags.
+	// Both providers are configured with the same config, so they set the same tags.
+	defaultTags   = make(map[string]string)
+	defaultTagsMu sync.RWMutex
+)
+
+// SetDefaultTags sets the provider default_tags, that are added to the tags of all taggable resources.
+func SetDefaultTags(tags map[string]string) {
+	defaultTagsMu.Lock()
+	defer defaultTagsMu.Unlock()
+	defaultTags = maps.Clone(tags)
+	if defaultTags == nil {
+		defaultTags = make(map[string]string)
+	}
+}
+
+// DefaultTags returns a copy of the provider default_tags.
+func DefaultTags() map[string]string {
+	defaultTagsMu.RLock()
+	defer defaultTagsMu.RUnlock()
+	return maps.Clone(defaultTags)
+}
+
+// MergeDefaultTags returns the default tags merged with the resource tags, the resource tags win.
+func MergeDefaultTags(tags map[string]string) map[string]string {
+	result := DefaultTags()
+	maps.Copy(result, tags)
+	return result
+}
+
+// WithoutDefaultTags removes the default tags from the tags read from the API,
+// unless the resource sets the tag itself (the known tags).
+// A tag with a value different from the default is set by the resource, so it is kept.
+func WithoutDefaultTags(tags, known map[string]string) map[string]string {
+	defaults := DefaultTags()
+	result := make(map[string]string, len(tags))
+	for k, v := range tags {
+		if _, ok := known[k]; !ok {
+			if d, ok := defaults[k]; ok && d == v {
+				continue
+			}
+		}
+		result[k] = v
+	}
+	return result
+}
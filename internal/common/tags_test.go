@@ -0,0 +1,31 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefaultTags(t *testing.T) {
+	SetDefaultTags(map[string]string{"owner": "team", "env": "dev"})
+	t.Cleanup(func() { SetDefaultTags(nil) })
+
+	// The resource tags win
+	merged := MergeDefaultTags(map[string]string{"env": "prod", "app": "foo"})
+	assert.Equal(t, map[string]string{"owner": "team", "env": "prod", "app": "foo"}, merged)
+
+	// The default tags are not stored, unless the resource sets them
+	actual := WithoutDefaultTags(
+		map[string]string{"owner": "team", "env": "dev", "app": "foo"},
+		map[string]string{"env": "dev", "app": "foo"},
+	)
+	assert.Equal(t, map[string]string{"env": "dev", "app": "foo"}, actual)
+
+	// The tag with another value is kept, for instance, it was changed outside Terraform
+	actual = WithoutDefaultTags(map[string]string{"owner": "other"}, nil)
+	assert.Equal(t, map[string]string{"owner": "other"}, actual)
+
+	// Returns a copy
+	DefaultTags()["owner"] = "changed"
+	assert.Equal(t, map[string]string{"owner": "team", "env": "dev"}, DefaultTags())
+}
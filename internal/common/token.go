@@ -0,0 +1,191 @@
+package common
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+)
+
+// tokenCommandTimeout limits the time the token command may run.
+const tokenCommandTimeout = time.Minute
+
+// TokenSourceError is returned when the token cannot be read from the file or from the command.
+type TokenSourceError struct {
+	Source string // for instance, "file /run/secrets/aiven"
+	Err    error
+}
+
+func (e *TokenSourceError) Error() string {
+	return fmt.Sprintf("failed to read the token from %s: %s", e.Source, e.Err)
+}
+
+func (e *TokenSourceError) Unwrap() error {
+	return e.Err
+}
+
+// tokenSource holds the token read from a file or from a command.
+// The token is read again when the API responds with 401, for instance, when the token has been rotated.
+type tokenSource struct {
+	mu     sync.Mutex
+	name   string
+	token  string
+	readFn func(ctx context.Context) (string, error)
+}
+
+var (
+	// tokenSources are shared by all clients, so the token is read once for all of them.
+	tokenSources   = make(map[string]*tokenSource)
+	tokenSourcesMu sync.Mutex
+)
+
+// getTokenSource returns the cached token source or creates a new one and reads the token.
+func getTokenSource(ctx context.Context, name string, readFn func(ctx context.Context) (string, error)) (*tokenSource, error) {
+	tokenSourcesMu.Lock()
+	defer tokenSourcesMu.Unlock()
+	if s, ok := tokenSources[name]; ok {
+		return s, nil
+	}
+
+	s := &tokenSource{name: name, readFn: readFn}
+	token, err := s.read(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	s.token = token
+	tokenSources[name] = s
+	return s, nil
+}
+
+// newTokenFileSource reads the token from the file. Leading and trailing whitespace is trimmed.
+func newTokenFileSource(ctx context.Context, path string) (*tokenSource, error) {
+	return getTokenSource(ctx, "file "+path, func(context.Context) (string, error) {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return "", err
+		}
+		return string(b), nil
+	})
+}
+
+// newTokenCommandSource runs the command and reads the token from its output.
+// The command and its arguments are separated by spaces, the shell is not used.
+func newTokenCommandSource(ctx context.Context, command string) (*tokenSource, error) {
+	return getTokenSource(ctx, "command "+command, func(ctx context.Context) (string, error) {
+		args := strings.Fields(command)
+		if len(args) == 0 {
+			return "", fmt.Errorf("the command is empty")
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, tokenCommandTimeout)
+		defer cancel()
+
+		var stderr bytes.Buffer
+		cmd := exec.CommandContext(ctx, args[0], args[1:]...) //nolint:gosec // the command is set by the user
+		cmd.Stderr = &stderr
+		out, err := cmd.Output()
+		if err != nil {
+			if msg := strings.TrimSpace(stderr.String()); msg != "" {
+				return "", fmt.Errorf("%w: %s", err, msg)
+			}
+			return "", err
+		}
+		return string(out), nil
+	})
+}
+
+func (s *tokenSource) read(ctx context.Context) (string, error) {
+	token, err := s.readFn(ctx)
+	if err == nil {
+		token = strings.TrimSpace(token)
+		if token == "" {
+			err = fmt.Errorf("the token is empty")
+		}
+	}
+
+	if err != nil {
+		return "", &TokenSourceError{Source: s.name, Err: err}
+	}
+	return token, nil
+}
+
+// Token returns the current token.
+func (s *tokenSource) Token() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.token
+}
+
+// refresh reads the token again, unless another request has already replaced the old one.
+func (s *tokenSource) refresh(ctx context.Context, old string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.token != old {
+		return s.token, nil
+	}
+
+	token, err := s.read(ctx)
+	if err != nil {
+		return "", err
+	}
+	s.token = token
+	return token, nil
+}
+
+// tokenTransport sets the Authorization header to the current token of the source.
+// When the API responds with 401, it reads the token again and repeats the request once, if the token has changed.
+type tokenTransport struct {
+	next   http.RoundTripper
+	source *tokenSource
+}
+
+func (t *tokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// The body must be sent twice on 401
+	req, err := bufferBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	token := t.source.Token()
+	rsp, err := t.next.RoundTrip(withToken(req, token))
+	if err != nil || rsp.StatusCode != http.StatusUnauthorized {
+		return rsp, err
+	}
+
+	newToken, err := t.source.refresh(req.Context(), token)
+	if err != nil {
+		_ = rsp.Body.Close()
+		return nil, err
+	}
+
+	if newToken == token {
+		return rsp, nil
+	}
+
+	retry := withToken(req, newToken)
+	if req.GetBody != nil {
+		retry.Body, err = req.GetBody()
+		if err != nil {
+			_ = rsp.Body.Close()
+			return nil, err
+		}
+	}
+
+	_, _ = io.Copy(io.Discard, rsp.Body)
+	_ = rsp.Body.Close()
+	return t.next.RoundTrip(retry)
+}
+
+// withToken returns a copy of the request with the token, RoundTrip must not modify the original request.
+func withToken(req *http.Request, token string) *http.Request {
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "aivenv1 "+token)
+	return req
+}
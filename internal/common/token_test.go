@@ -0,0 +1,138 @@
+package common
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewClientOptsToken(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("file token\n"), 0o600))
+
+	cases := []struct {
+		name         string
+		opts         []ClientOpt
+		env          map[string]string
+		expectToken  string
+		expectSource bool
+		expectErr    string
+	}{
+		{
+			name:         "file from env",
+			env:          map[string]string{"AIVEN_TOKEN_FILE": tokenFile},
+			expectToken:  "file token",
+			expectSource: true,
+		},
+		{
+			name:         "command from env",
+			env:          map[string]string{"AIVEN_TOKEN_COMMAND": "echo command token"},
+			expectToken:  "command token",
+			expectSource: true,
+		},
+		{
+			name:        "env token takes precedence",
+			env:         map[string]string{"AIVEN_TOKEN": "env token", "AIVEN_TOKEN_FILE": tokenFile},
+			expectToken: "env token",
+		},
+		{
+			name:         "file option overrides env token",
+			env:          map[string]string{"AIVEN_TOKEN": "env token"},
+			opts:         []ClientOpt{TokenFileOpt(tokenFile)},
+			expectToken:  "file token",
+			expectSource: true,
+		},
+		{
+			name:        "token option overrides env file",
+			env:         map[string]string{"AIVEN_TOKEN_FILE": tokenFile},
+			opts:        []ClientOpt{TokenOpt("opt token")},
+			expectToken: "opt token",
+		},
+		{
+			name:      "missing file",
+			opts:      []ClientOpt{TokenFileOpt(filepath.Join(t.TempDir(), "missing"))},
+			expectErr: "failed to read the token from file",
+		},
+		{
+			name:      "empty command output",
+			opts:      []ClientOpt{TokenCommandOpt("true")},
+			expectErr: "failed to read the token from command true: the token is empty",
+		},
+	}
+
+	for _, o := range cases {
+		t.Run(o.name, func(t *testing.T) {
+			for _, k := range []string{"AIVEN_TOKEN", "AIVEN_TOKEN_FILE", "AIVEN_TOKEN_COMMAND"} {
+				t.Setenv(k, o.env[k])
+			}
+
+			actual, err := newClientOpts(o.opts...)
+			if o.expectErr != "" {
+				var tokenErr *TokenSourceError
+				require.ErrorAs(t, err, &tokenErr)
+				assert.ErrorContains(t, err, o.expectErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, o.expectToken, actual.token)
+			assert.Equal(t, o.expectSource, actual.tokenSource != nil)
+		})
+	}
+}
+
+func TestTokenTransport(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("old"), 0o600))
+
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		if r.Header.Get("Authorization") != "aivenv1 new" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		b, _ := io.ReadAll(r.Body)
+		_, _ = w.Write(b)
+	}))
+	defer server.Close()
+
+	source, err := newTokenFileSource(t.Context(), tokenFile)
+	require.NoError(t, err)
+	client := &http.Client{Transport: &tokenTransport{next: http.DefaultTransport, source: source}}
+
+	// The token has not changed, returns 401
+	rsp, err := client.Post(server.URL, "text/plain", strings.NewReader("foo"))
+	require.NoError(t, err)
+	_ = rsp.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, rsp.StatusCode)
+	assert.EqualValues(t, 1, calls.Load())
+
+	// The token is rotated, repeats the request with the new token and the same body
+	require.NoError(t, os.WriteFile(tokenFile, []byte("new"), 0o600))
+	rsp, err = client.Post(server.URL, "text/plain", strings.NewReader("bar"))
+	require.NoError(t, err)
+	defer rsp.Body.Close()
+	b, err := io.ReadAll(rsp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rsp.StatusCode)
+	assert.Equal(t, "bar", string(b))
+	assert.EqualValues(t, 3, calls.Load())
+	assert.Equal(t, "new", source.Token())
+
+	// The file is removed, the request fails with the token error
+	require.NoError(t, os.Remove(tokenFile))
+	source.token = "expired"
+	_, err = client.Get(server.URL)
+	var tokenErr *TokenSourceError
+	require.ErrorAs(t, err, &tokenErr)
+}
@@ -0,0 +1,175 @@
+package fakeapi
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/samber/lo"
+)
+
+type integration struct {
+	id               string
+	project          string
+	integrationType  string
+	sourceProject    string
+	sourceService    *string
+	sourceEndpointID *string
+	destProject      string
+	destService      *string
+	destEndpointID   *string
+	userConfig       map[string]any
+}
+
+func (i *integration) out() map[string]any {
+	return map[string]any{
+		"service_integration_id": i.id,
+		"integration_type":       i.integrationType,
+		"source_project":         i.sourceProject,
+		"source_service":         lo.FromPtr(i.sourceService),
+		"source_service_type":    "",
+		"source_endpoint_id":     i.sourceEndpointID,
+		"dest_project":           i.destProject,
+		"dest_service":           i.destService,
+		"dest_service_type":      "",
+		"dest_endpoint_id":       i.destEndpointID,
+		"user_config":            i.userConfig,
+		"active":                 true,
+		"enabled":                true,
+		"description":            "",
+		"integration_status":     map[string]any{"state": map[string]any{"status": "running"}},
+	}
+}
+
+// serviceIntegrations returns the integrations of the service, source or destination.
+func (s *Server) serviceIntegrations(project, serviceName string) []*integration {
+	result := make([]*integration, 0)
+	for _, k := range sortedKeys(s.integrations) {
+		i := s.integrations[k]
+		isSource := i.sourceProject == project && lo.FromPtr(i.sourceService) == serviceName
+		isDest := i.destProject == project && lo.FromPtr(i.destService) == serviceName
+		if isSource || isDest {
+			result = append(result, i)
+		}
+	}
+	return result
+}
+
+// getIntegration returns the integration from the path or writes 404.
+func (s *Server) getIntegration(w http.ResponseWriter, r *http.Request) (*integration, bool) {
+	p, ok := s.getProject(w, r)
+	if !ok {
+		return nil, false
+	}
+
+	i, ok := s.integrations[r.PathValue("integration_id")]
+	if !ok || i.project != p.name {
+		writeError(w, http.StatusNotFound, "Service integration not found")
+		return nil, false
+	}
+	return i, true
+}
+
+func (s *Server) serviceIntegrationList(w http.ResponseWriter, r *http.Request) {
+	srv, ok := s.getService(w, r)
+	if !ok {
+		return
+	}
+
+	integrations := make([]map[string]any, 0)
+	for _, i := range s.serviceIntegrations(srv.project, srv.name) {
+		integrations = append(integrations, i.out())
+	}
+	writeOK(w, "service_integrations", integrations)
+}
+
+func (s *Server) serviceIntegrationCreate(w http.ResponseWriter, r *http.Request) {
+	p, ok := s.getProject(w, r)
+	if !ok {
+		return
+	}
+
+	var req struct {
+		IntegrationType  string         `json:"integration_type"`
+		SourceProject    *string        `json:"source_project"`
+		SourceService    *string        `json:"source_service"`
+		SourceEndpointID *string        `json:"source_endpoint_id"`
+		DestProject      *string        `json:"dest_project"`
+		DestService      *string        `json:"dest_service"`
+		DestEndpointID   *string        `json:"dest_endpoint_id"`
+		UserConfig       map[string]any `json:"user_config"`
+	}
+
+	if !readJSON(w, r, &req) {
+		return
+	}
+
+	i := &integration{
+		id:               fmt.Sprintf("00000000-0000-0000-0000-%012d", s.nextID()),
+		project:          p.name,
+		integrationType:  req.IntegrationType,
+		sourceProject:    lo.FromPtrOr(req.SourceProject, p.name),
+		sourceService:    req.SourceService,
+		sourceEndpointID: req.SourceEndpointID,
+		destProject:      lo.FromPtrOr(req.DestProject, p.name),
+		destService:      req.DestService,
+		destEndpointID:   req.DestEndpointID,
+		userConfig:       lo.CoalesceMapOrEmpty(req.UserConfig),
+	}
+
+	// Services must exist, endpoints are not supported
+	for _, ref := range [][2]string{{i.sourceProject, lo.FromPtr(i.sourceService)}, {i.destProject, lo.FromPtr(i.destService)}} {
+		if ref[1] == "" {
+			continue
+		}
+
+		refProject, ok := s.projects[ref[0]]
+		if !ok {
+			writeError(w, http.StatusNotFound, fmt.Sprintf("Project %q not found", ref[0]))
+			return
+		}
+
+		if _, ok := refProject.services[ref[1]]; !ok {
+			writeError(w, http.StatusNotFound, fmt.Sprintf("Service %q not found", ref[1]))
+			return
+		}
+	}
+
+	s.integrations[i.id] = i
+	writeOK(w, "service_integration", i.out())
+}
+
+func (s *Server) serviceIntegrationGet(w http.ResponseWriter, r *http.Request) {
+	i, ok := s.getIntegration(w, r)
+	if !ok {
+		return
+	}
+	writeOK(w, "service_integration", i.out())
+}
+
+func (s *Server) serviceIntegrationUpdate(w http.ResponseWriter, r *http.Request) {
+	i, ok := s.getIntegration(w, r)
+	if !ok {
+		return
+	}
+
+	var req struct {
+		UserConfig map[string]any `json:"user_config"`
+	}
+
+	if !readJSON(w, r, &req) {
+		return
+	}
+
+	i.userConfig = lo.CoalesceMapOrEmpty(req.UserConfig)
+	writeOK(w, "service_integration", i.out())
+}
+
+func (s *Server) serviceIntegrationDelete(w http.ResponseWriter, r *http.Request) {
+	i, ok := s.getIntegration(w, r)
+	if !ok {
+		return
+	}
+
+	delete(s.integrations, i.id)
+	writeOK(w, "", nil)
+}
@@ -0,0 +1,373 @@
+package fakeapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+)
+
+const (
+	defaultPartitions  = 1
+	defaultReplication = 2
+)
+
+// topicConfigDefaults are the Kafka defaults of the topic config.
+// The API returns all of them, the ones that match the default come from the default_config.
+var topicConfigDefaults = map[string]any{
+	"cleanup_policy":                 "delete",
+	"compression_type":               "producer",
+	"max_message_bytes":              1048576,
+	"message_timestamp_type":         "CreateTime",
+	"min_insync_replicas":            1,
+	"preallocate":                    false,
+	"retention_bytes":                -1,
+	"retention_ms":                   604800000,
+	"segment_bytes":                  1073741824,
+	"unclean_leader_election_enable": false,
+}
+
+type topic struct {
+	name              string
+	partitions        int
+	replication       int
+	config            map[string]any
+	tags              []any
+	description       *string
+	ownerUserGroupID  *string
+	minInsyncReplicas int
+}
+
+// topicIn is the create and update request
+type topicIn struct {
+	TopicName         string         `json:"topic_name"`
+	Partitions        *int           `json:"partitions"`
+	Replication       *int           `json:"replication"`
+	Config            map[string]any `json:"config"`
+	Tags              []any          `json:"tags"`
+	TopicDescription  *string        `json:"topic_description"`
+	OwnerUserGroupID  *string        `json:"owner_user_group_id"`
+	MinInsyncReplicas *int           `json:"min_insync_replicas"`
+}
+
+// listOut is the short form returned by the V1 list.
+func (t *topic) listOut() map[string]any {
+	return map[string]any{
+		"topic_name":          t.name,
+		"partitions":          t.partitions,
+		"replication":         t.replication,
+		"min_insync_replicas": t.minInsyncReplicas,
+		"cleanup_policy":      t.cleanupPolicy(),
+		"retention_bytes":     -1,
+		"state":               "ACTIVE",
+		"tags":                t.tags,
+		"topic_description":   t.description,
+		"owner_user_group_id": t.ownerUserGroupID,
+	}
+}
+
+func (t *topic) out() map[string]any {
+	// The API returns each config value with its source
+	config := make(map[string]any, len(t.config)+len(topicConfigDefaults))
+	for k, v := range topicConfigDefaults {
+		config[k] = configValue("default_config", v)
+	}
+
+	for k, v := range t.config {
+		source := "topic_config"
+		if d, ok := topicConfigDefaults[k]; ok && sameJSON(d, v) {
+			source = "default_config"
+		}
+		config[k] = configValue(source, v)
+	}
+
+	partitions := make([]map[string]any, t.partitions)
+	for i := range partitions {
+		partitions[i] = map[string]any{
+			"partition":       i,
+			"isr":             t.replication,
+			"size":            0,
+			"earliest_offset": 0,
+			"latest_offset":   0,
+			"consumer_groups": []any{},
+		}
+	}
+
+	out := t.listOut()
+	out["config"] = config
+	out["partitions"] = partitions
+	return out
+}
+
+func configValue(source string, v any) map[string]any {
+	return map[string]any{
+		"source":   source,
+		"value":    v,
+		"synonyms": []any{},
+	}
+}
+
+// sameJSON compares the values read from the request with the Go values.
+func sameJSON(a, b any) bool {
+	x, errX := json.Marshal(a)
+	y, errY := json.Marshal(b)
+	return errX == nil && errY == nil && bytes.Equal(x, y)
+}
+
+func (t *topic) cleanupPolicy() string {
+	if v, ok := t.config["cleanup_policy"].(string); ok {
+		return v
+	}
+	return "delete"
+}
+
+// update applies the set fields
+func (t *topic) update(req *topicIn) {
+	if req.Partitions != nil {
+		t.partitions = *req.Partitions
+	}
+
+	if req.Replication != nil {
+		t.replication = *req.Replication
+	}
+
+	if req.MinInsyncReplicas != nil {
+		t.minInsyncReplicas = *req.MinInsyncReplicas
+	}
+
+	for k, v := range req.Config {
+		t.config[k] = v
+	}
+
+	if req.Tags != nil {
+		t.tags = req.Tags
+	}
+
+	if req.TopicDescription != nil {
+		t.description = req.TopicDescription
+	}
+
+	if req.OwnerUserGroupID != nil {
+		t.ownerUserGroupID = req.OwnerUserGroupID
+	}
+}
+
+// getTopic returns the service and the topic from the path or writes 404.
+func (s *Server) getTopic(w http.ResponseWriter, r *http.Request) (*service, *topic, bool) {
+	srv, ok := s.getService(w, r)
+	if !ok {
+		return nil, nil, false
+	}
+
+	t, ok := srv.topics[r.PathValue("topic_name")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Topic not found")
+	}
+	return srv, t, ok
+}
+
+func (s *Server) kafkaTopicList(w http.ResponseWriter, r *http.Request) {
+	srv, ok := s.getService(w, r)
+	if !ok {
+		return
+	}
+
+	topics := make([]map[string]any, 0, len(srv.topics))
+	for _, k := range sortedKeys(srv.topics) {
+		topics = append(topics, srv.topics[k].listOut())
+	}
+	writeOK(w, "topics", topics)
+}
+
+// kafkaTopicListV2 returns the full topics by names.
+func (s *Server) kafkaTopicListV2(w http.ResponseWriter, r *http.Request) {
+	srv, ok := s.getService(w, r)
+	if !ok {
+		return
+	}
+
+	var req struct {
+		TopicNames []string `json:"topic_names"`
+	}
+
+	if !readJSON(w, r, &req) {
+		return
+	}
+
+	topics := make([]map[string]any, 0, len(req.TopicNames))
+	for _, name := range req.TopicNames {
+		t, ok := srv.topics[name]
+		if !ok {
+			writeError(w, http.StatusNotFound, fmt.Sprintf("Topic %q not found", name))
+			return
+		}
+		topics = append(topics, t.out())
+	}
+	writeOK(w, "topics", topics)
+}
+
+func (s *Server) kafkaTopicCreate(w http.ResponseWriter, r *http.Request) {
+	srv, ok := s.getService(w, r)
+	if !ok {
+		return
+	}
+
+	var req topicIn
+	if !readJSON(w, r, &req) {
+		return
+	}
+
+	if _, ok := srv.topics[req.TopicName]; ok {
+		writeError(w, http.StatusConflict, "Topic conflicts with another topic")
+		return
+	}
+
+	t := &topic{
+		name:              req.TopicName,
+		partitions:        defaultPartitions,
+		replication:       defaultReplication,
+		minInsyncReplicas: 1,
+		config:            make(map[string]any),
+		tags:              []any{},
+	}
+	t.update(&req)
+	srv.topics[t.name] = t
+	writeOK(w, "", nil)
+}
+
+func (s *Server) kafkaTopicGet(w http.ResponseWriter, r *http.Request) {
+	_, t, ok := s.getTopic(w, r)
+	if !ok {
+		return
+	}
+	writeOK(w, "topic", t.out())
+}
+
+func (s *Server) kafkaTopicUpdate(w http.ResponseWriter, r *http.Request) {
+	_, t, ok := s.getTopic(w, r)
+	if !ok {
+		return
+	}
+
+	var req topicIn
+	if !readJSON(w, r, &req) {
+		return
+	}
+
+	if req.Partitions != nil && *req.Partitions < t.partitions {
+		writeError(w, http.StatusBadRequest, "The number of partitions cannot be decreased")
+		return
+	}
+
+	t.update(&req)
+	writeOK(w, "", nil)
+}
+
+func (s *Server) kafkaTopicDelete(w http.ResponseWriter, r *http.Request) {
+	srv, t, ok := s.getTopic(w, r)
+	if !ok {
+		return
+	}
+
+	delete(srv.topics, t.name)
+	writeOK(w, "", nil)
+}
+
+type acl struct {
+	id         string
+	permission string
+	topic      string
+	username   string
+}
+
+func (a *acl) out() map[string]any {
+	return map[string]any{
+		"id":         a.id,
+		"permission": a.permission,
+		"topic":      a.topic,
+		"username":   a.username,
+	}
+}
+
+func aclsOut(acls []*acl) []map[string]any {
+	out := make([]map[string]any, len(acls))
+	for i, a := range acls {
+		out[i] = a.out()
+	}
+	return out
+}
+
+func (s *Server) kafkaACLList(w http.ResponseWriter, r *http.Request) {
+	srv, ok := s.getService(w, r)
+	if !ok {
+		return
+	}
+	writeOK(w, "acl", aclsOut(srv.acls))
+}
+
+// kafkaACLAdd returns all ACLs of the service, like the real API.
+func (s *Server) kafkaACLAdd(w http.ResponseWriter, r *http.Request) {
+	srv, ok := s.getService(w, r)
+	if !ok {
+		return
+	}
+
+	var req struct {
+		Permission string `json:"permission"`
+		Topic      string `json:"topic"`
+		Username   string `json:"username"`
+	}
+
+	if !readJSON(w, r, &req) {
+		return
+	}
+
+	for _, a := range srv.acls {
+		if a.permission == req.Permission && a.topic == req.Topic && a.username == req.Username {
+			writeError(w, http.StatusConflict, "Identical ACL entry already exists")
+			return
+		}
+	}
+
+	srv.acls = append(srv.acls, &acl{
+		id:         fmt.Sprintf("acl%08x", s.nextID()),
+		permission: req.Permission,
+		topic:      req.Topic,
+		username:   req.Username,
+	})
+	writeOK(w, "acl", aclsOut(srv.acls))
+}
+
+func (s *Server) kafkaACLGet(w http.ResponseWriter, r *http.Request) {
+	srv, ok := s.getService(w, r)
+	if !ok {
+		return
+	}
+
+	for _, a := range srv.acls {
+		if a.id == r.PathValue("acl_id") {
+			writeOK(w, "acl", a.out())
+			return
+		}
+	}
+	writeError(w, http.StatusNotFound, "ACL entry not found")
+}
+
+func (s *Server) kafkaACLDelete(w http.ResponseWriter, r *http.Request) {
+	srv, ok := s.getService(w, r)
+	if !ok {
+		return
+	}
+
+	i := slices.IndexFunc(srv.acls, func(a *acl) bool {
+		return a.id == r.PathValue("acl_id")
+	})
+	if i < 0 {
+		writeError(w, http.StatusNotFound, "ACL entry not found")
+		return
+	}
+
+	srv.acls = slices.Delete(srv.acls, i, i+1)
+	writeOK(w, "acl", aclsOut(srv.acls))
+}
@@ -0,0 +1,90 @@
+package fakeapi
+
+import (
+	"net/http"
+)
+
+type project struct {
+	name     string
+	services map[string]*service
+}
+
+func newProject(name string) *project {
+	return &project{
+		name:     name,
+		services: make(map[string]*service),
+	}
+}
+
+func (p *project) out() map[string]any {
+	return map[string]any{
+		"project_name":          p.name,
+		"account_id":            "a00000000000",
+		"organization_id":       "org00000000000",
+		"billing_group_id":      "00000000-0000-0000-0000-000000000000",
+		"default_cloud":         "google-europe-west1",
+		"estimated_balance":     "0.00",
+		"available_credits":     "0.00",
+		"tech_emails":           []any{},
+		"tags":                  map[string]any{},
+		"features":              map[string]any{},
+		"end_of_life_extension": map[string]any{},
+	}
+}
+
+func (s *Server) projectList(w http.ResponseWriter, _ *http.Request) {
+	projects := make([]map[string]any, 0, len(s.projects))
+	for _, k := range sortedKeys(s.projects) {
+		projects = append(projects, s.projects[k].out())
+	}
+	writeOK(w, "projects", projects)
+}
+
+func (s *Server) projectCreate(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Project string `json:"project"`
+	}
+
+	if !readJSON(w, r, &req) {
+		return
+	}
+
+	if _, ok := s.projects[req.Project]; ok {
+		writeError(w, http.StatusConflict, "Project already exists")
+		return
+	}
+
+	p := newProject(req.Project)
+	s.projects[req.Project] = p
+	writeOK(w, "project", p.out())
+}
+
+func (s *Server) projectGet(w http.ResponseWriter, r *http.Request) {
+	p, ok := s.getProject(w, r)
+	if !ok {
+		return
+	}
+	writeOK(w, "project", p.out())
+}
+
+func (s *Server) projectDelete(w http.ResponseWriter, r *http.Request) {
+	p, ok := s.getProject(w, r)
+	if !ok {
+		return
+	}
+
+	if len(p.services) > 0 {
+		writeError(w, http.StatusConflict, "Project has services")
+		return
+	}
+
+	delete(s.projects, p.name)
+	writeOK(w, "", nil)
+}
+
+func (s *Server) staticIPList(w http.ResponseWriter, r *http.Request) {
+	if _, ok := s.getProject(w, r); !ok {
+		return
+	}
+	writeOK(w, "static_ips", []any{})
+}
@@ -0,0 +1,211 @@
+// Package fakeapi is a local stand-in for the subset of the Aiven REST API used by the provider.
+// It keeps everything in memory and moves services through deterministic state transitions,
+// so the acceptance tests can run without network access, see acctest.FakeAPIProvider and PROVIDER_AIVEN_FAKE_API.
+// It also runs on its own, to try a configuration locally:
+//
+//	go run ./tools fakeapi --addr localhost:8080 --project test-acc
+//	AIVEN_WEB_URL=http://localhost:8080 AIVEN_TOKEN=fake AIVEN_PROJECT_NAME=test-acc terraform plan
+//
+// Covers projects, services, service users, databases, Kafka topics and ACLs, and service integrations.
+// Any other endpoint returns 404 with a message that names it, so the missing ones are easy to spot.
+// The clients do not retry 404, unlike 501.
+package fakeapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+const (
+	// defaultReadsUntilRunning is the number of service reads that return REBUILDING after a create.
+	defaultReadsUntilRunning = 1
+
+	// fakeTime is the creation time of all objects, so responses are deterministic
+	fakeTime = "2024-01-01T00:00:00Z"
+)
+
+// Option configures the Server.
+type Option func(s *Server)
+
+// WithProject creates a project on start, like the one from AIVEN_PROJECT_NAME.
+func WithProject(name string) Option {
+	return func(s *Server) {
+		s.projects[name] = newProject(name)
+	}
+}
+
+// WithReadsUntilRunning sets how many times a new service is read with the REBUILDING state,
+// before it turns RUNNING.
+func WithReadsUntilRunning(n int) Option {
+	return func(s *Server) {
+		s.readsUntilRunning = n
+	}
+}
+
+// Server is an http.Handler that serves the fake API.
+type Server struct {
+	mu                sync.Mutex
+	mux               *http.ServeMux
+	projects          map[string]*project
+	integrations      map[string]*integration
+	readsUntilRunning int
+
+	// lastID is used to build unique and predictable IDs, see nextID
+	lastID int
+}
+
+// New returns a new Server.
+func New(opts ...Option) *Server {
+	s := &Server{
+		mux:               http.NewServeMux(),
+		projects:          make(map[string]*project),
+		integrations:      make(map[string]*integration),
+		readsUntilRunning: defaultReadsUntilRunning,
+	}
+
+	for _, o := range opts {
+		o(s)
+	}
+
+	s.routes()
+	return s
+}
+
+func (s *Server) routes() {
+	routes := map[string]http.HandlerFunc{
+		"GET /v1/project":                      s.projectList,
+		"POST /v1/project":                     s.projectCreate,
+		"GET /v1/project/{project}":            s.projectGet,
+		"DELETE /v1/project/{project}":         s.projectDelete,
+		"GET /v1/project/{project}/static-ips": s.staticIPList,
+
+		"GET /v1/project/{project}/service-plans/{service_type}/{service_plan}":                              s.servicePlanSpecsGet,
+		"GET /v1/project/{project}/pricing/service-types/{service_type}/plans/{service_plan}/clouds/{cloud}": s.servicePlanPriceGet,
+
+		"GET /v1/project/{project}/service":                           s.serviceList,
+		"POST /v1/project/{project}/service":                          s.serviceCreate,
+		"GET /v1/project/{project}/service/{service_name}":            s.serviceGet,
+		"PUT /v1/project/{project}/service/{service_name}":            s.serviceUpdate,
+		"DELETE /v1/project/{project}/service/{service_name}":         s.serviceDelete,
+		"GET /v1/project/{project}/service/{service_name}/tags":       s.serviceTagsList,
+		"PUT /v1/project/{project}/service/{service_name}/tags":       s.serviceTagsReplace,
+		"GET /v1/project/{project}/service/{service_name}/connectors": s.serviceConnectorList,
+
+		"POST /v1/project/{project}/service/{service_name}/user":                             s.serviceUserCreate,
+		"GET /v1/project/{project}/service/{service_name}/user/{username}":                   s.serviceUserGet,
+		"PUT /v1/project/{project}/service/{service_name}/user/{username}":                   s.serviceUserCredentialsModify,
+		"PUT /v1/project/{project}/service/{service_name}/user/{username}/credentials/reset": s.serviceUserCredentialsReset,
+		"DELETE /v1/project/{project}/service/{service_name}/user/{username}":                s.serviceUserDelete,
+		"GET /v1/project/{project}/service/{service_name}/db":                                s.serviceDatabaseList,
+		"POST /v1/project/{project}/service/{service_name}/db":                               s.serviceDatabaseCreate,
+		"DELETE /v1/project/{project}/service/{service_name}/db/{dbname}":                    s.serviceDatabaseDelete,
+
+		"GET /v1/project/{project}/service/{service_name}/topic":                 s.kafkaTopicList,
+		"POST /v1/project/{project}/service/{service_name}/topic":                s.kafkaTopicCreate,
+		"POST /v2/project/{project}/service/{service_name}/topic":                s.kafkaTopicListV2,
+		"GET /v1/project/{project}/service/{service_name}/topic/{topic_name}":    s.kafkaTopicGet,
+		"PUT /v1/project/{project}/service/{service_name}/topic/{topic_name}":    s.kafkaTopicUpdate,
+		"DELETE /v1/project/{project}/service/{service_name}/topic/{topic_name}": s.kafkaTopicDelete,
+		"GET /v1/project/{project}/service/{service_name}/acl":                   s.kafkaACLList,
+		"POST /v1/project/{project}/service/{service_name}/acl":                  s.kafkaACLAdd,
+		"GET /v1/project/{project}/service/{service_name}/acl/{acl_id}":          s.kafkaACLGet,
+		"DELETE /v1/project/{project}/service/{service_name}/acl/{acl_id}":       s.kafkaACLDelete,
+
+		"GET /v1/project/{project}/service/{service_name}/integration": s.serviceIntegrationList,
+		"POST /v1/project/{project}/integration":                       s.serviceIntegrationCreate,
+		"GET /v1/project/{project}/integration/{integration_id}":       s.serviceIntegrationGet,
+		"PUT /v1/project/{project}/integration/{integration_id}":       s.serviceIntegrationUpdate,
+		"DELETE /v1/project/{project}/integration/{integration_id}":    s.serviceIntegrationDelete,
+	}
+
+	for pattern, h := range routes {
+		s.mux.HandleFunc(pattern, h)
+	}
+}
+
+// ServeHTTP checks the token and serves the request.
+// All handlers run under the lock, so the state changes are sequential.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "aivenv1 ") {
+		writeError(w, http.StatusForbidden, "Invalid token")
+		return
+	}
+
+	if _, pattern := s.mux.Handler(r); pattern == "" {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("%s %s is not implemented by the fake API", r.Method, r.URL.Path))
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.mux.ServeHTTP(w, r)
+}
+
+// nextID returns a predictable unique ID.
+func (s *Server) nextID() int {
+	s.lastID++
+	return s.lastID
+}
+
+// getProject returns the project from the path or writes 404.
+func (s *Server) getProject(w http.ResponseWriter, r *http.Request) (*project, bool) {
+	p, ok := s.projects[r.PathValue("project")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Project does not exist")
+	}
+	return p, ok
+}
+
+// getService returns the service from the path or writes 404.
+func (s *Server) getService(w http.ResponseWriter, r *http.Request) (*service, bool) {
+	p, ok := s.getProject(w, r)
+	if !ok {
+		return nil, false
+	}
+
+	srv, ok := p.services[r.PathValue("service_name")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Service not found")
+	}
+	return srv, ok
+}
+
+// readJSON decodes the request body or writes 400.
+func readJSON(w http.ResponseWriter, r *http.Request, v any) bool {
+	// Keeps the numbers of the user config and the topic config as they are, for instance, max int64
+	dec := json.NewDecoder(r.Body)
+	dec.UseNumber()
+	if err := dec.Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("Invalid JSON: %s", err))
+		return false
+	}
+	return true
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writeOK writes the object with the API envelope, for instance, {"service": {...}, "message": "..."}.
+func writeOK(w http.ResponseWriter, key string, v any) {
+	body := map[string]any{"message": "ok"}
+	if key != "" {
+		body[key] = v
+	}
+	writeJSON(w, http.StatusOK, body)
+}
+
+// writeError writes the error in the API format.
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]any{
+		"message": message,
+		"errors": []map[string]any{
+			{"message": message, "status": status},
+		},
+	})
+}
@@ -0,0 +1,216 @@
+package fakeapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testProject = "test-project"
+
+// do sends the request and returns the status code and the decoded body.
+func do(t *testing.T, h http.Handler, method, path string, body any) (int, map[string]any) {
+	t.Helper()
+
+	var b bytes.Buffer
+	if body != nil {
+		require.NoError(t, json.NewEncoder(&b).Encode(body))
+	}
+
+	req := httptest.NewRequest(method, path, &b)
+	req.Header.Set("Authorization", "aivenv1 token")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	var out map[string]any
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&out))
+	return rec.Code, out
+}
+
+func TestServerErrors(t *testing.T) {
+	s := New(WithProject(testProject))
+
+	// No token
+	req := httptest.NewRequest(http.MethodGet, "/v1/project", nil)
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+
+	// Not retried by the clients, unlike 501
+	code, out := do(t, s, http.MethodGet, "/v1/project/"+testProject+"/vpcs", nil)
+	assert.Equal(t, http.StatusNotFound, code)
+	assert.Contains(t, out["message"], "not implemented")
+
+	code, out = do(t, s, http.MethodGet, "/v1/project/"+testProject+"/service/foo", nil)
+	assert.Equal(t, http.StatusNotFound, code)
+	assert.Equal(t, "Service not found", out["message"])
+	assert.Len(t, out["errors"], 1)
+}
+
+func TestServiceStates(t *testing.T) {
+	s := New(WithProject(testProject), WithReadsUntilRunning(2))
+	path := "/v1/project/" + testProject + "/service"
+
+	code, out := do(t, s, http.MethodPost, path, map[string]any{
+		"service_name": "my-pg",
+		"service_type": "pg",
+		"plan":         "startup-4",
+	})
+	require.Equal(t, http.StatusOK, code)
+	assert.Equal(t, stateRebuilding, out["service"].(map[string]any)["state"])
+
+	code, _ = do(t, s, http.MethodPost, path, map[string]any{
+		"service_name": "my-pg",
+		"service_type": "pg",
+		"plan":         "startup-4",
+	})
+	assert.Equal(t, http.StatusConflict, code)
+
+	// Two reads return REBUILDING, then RUNNING
+	states := make([]any, 0)
+	for range 3 {
+		_, out = do(t, s, http.MethodGet, path+"/my-pg", nil)
+		states = append(states, out["service"].(map[string]any)["state"])
+	}
+	assert.Equal(t, []any{stateRebuilding, stateRebuilding, stateRunning}, states)
+
+	// PG backups and nodes appear when it is running
+	assert.Len(t, out["service"].(map[string]any)["backups"], 1)
+	assert.Len(t, out["service"].(map[string]any)["node_states"], 1)
+
+	code, out = do(t, s, http.MethodGet, "/v1/project/"+testProject+"/pricing/service-types/pg/plans/startup-4/clouds/google-europe-west1", nil)
+	require.Equal(t, http.StatusOK, code)
+	assert.Equal(t, basePriceUSD, out["base_price_usd"])
+
+	_, out = do(t, s, http.MethodPut, path+"/my-pg", map[string]any{"powered": false})
+	assert.Equal(t, statePoweroff, out["service"].(map[string]any)["state"])
+
+	_, out = do(t, s, http.MethodPut, path+"/my-pg", map[string]any{"powered": true})
+	assert.Equal(t, stateRebuilding, out["service"].(map[string]any)["state"])
+
+	code, _ = do(t, s, http.MethodDelete, path+"/my-pg", nil)
+	assert.Equal(t, http.StatusOK, code)
+
+	code, _ = do(t, s, http.MethodGet, path+"/my-pg", nil)
+	assert.Equal(t, http.StatusNotFound, code)
+}
+
+func TestServiceUsers(t *testing.T) {
+	s := New(WithProject(testProject))
+	path := "/v1/project/" + testProject + "/service"
+	do(t, s, http.MethodPost, path, map[string]any{"service_name": "my-pg", "service_type": "pg", "plan": "startup-4"})
+
+	code, out := do(t, s, http.MethodPost, path+"/my-pg/user", map[string]any{"username": "foo"})
+	require.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "normal", out["user"].(map[string]any)["type"])
+	pwd := out["user"].(map[string]any)["password"]
+	assert.NotEmpty(t, pwd)
+
+	// Passwords are predictable, but change on reset
+	_, out = do(t, s, http.MethodPut, path+"/my-pg/user/foo", map[string]any{"operation": "reset-credentials"})
+	assert.NotNil(t, out["service"])
+	_, out = do(t, s, http.MethodGet, path+"/my-pg/user/foo", nil)
+	assert.NotEqual(t, pwd, out["user"].(map[string]any)["password"])
+
+	_, out = do(t, s, http.MethodPut, path+"/my-pg/user/foo", map[string]any{"operation": "reset-credentials", "new_password": "bar"})
+	_, out = do(t, s, http.MethodGet, path+"/my-pg/user/foo", nil)
+	assert.Equal(t, "bar", out["user"].(map[string]any)["password"])
+
+	code, _ = do(t, s, http.MethodDelete, path+"/my-pg/user/avnadmin", nil)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	code, _ = do(t, s, http.MethodDelete, path+"/my-pg/user/foo", nil)
+	assert.Equal(t, http.StatusOK, code)
+
+	do(t, s, http.MethodPost, path+"/my-pg/db", map[string]any{"database": "mydb"})
+	_, out = do(t, s, http.MethodGet, path+"/my-pg/db", nil)
+	require.Len(t, out["databases"], 2) // defaultdb and mydb
+	assert.Equal(t, defaultLocale, out["databases"].([]any)[1].(map[string]any)["lc_collate"])
+}
+
+func TestKafka(t *testing.T) {
+	s := New(WithProject(testProject))
+	path := "/v1/project/" + testProject + "/service"
+	do(t, s, http.MethodPost, path, map[string]any{"service_name": "my-kafka", "service_type": "kafka", "plan": "business-4"})
+
+	code, _ := do(t, s, http.MethodPost, path+"/my-kafka/topic", map[string]any{
+		"topic_name":  "foo",
+		"partitions":  3,
+		"replication": 2,
+		"config":      map[string]any{"cleanup_policy": "compact"},
+	})
+	require.Equal(t, http.StatusOK, code)
+
+	_, out := do(t, s, http.MethodGet, path+"/my-kafka/topic", nil)
+	require.Len(t, out["topics"], 1)
+	assert.EqualValues(t, 3, out["topics"].([]any)[0].(map[string]any)["partitions"])
+
+	_, out = do(t, s, http.MethodPost, "/v2/project/"+testProject+"/service/my-kafka/topic", map[string]any{"topic_names": []string{"foo"}})
+	require.Len(t, out["topics"], 1)
+	topic := out["topics"].([]any)[0].(map[string]any)
+	assert.Len(t, topic["partitions"], 3)
+	config := topic["config"].(map[string]any)
+	assert.Equal(t, "compact", config["cleanup_policy"].(map[string]any)["value"])
+	assert.Equal(t, "topic_config", config["cleanup_policy"].(map[string]any)["source"])
+
+	// The unset values and the values that match the defaults come from the default config
+	assert.Equal(t, "default_config", config["retention_ms"].(map[string]any)["source"])
+	do(t, s, http.MethodPut, path+"/my-kafka/topic/foo", map[string]any{"config": map[string]any{"retention_ms": 604800000}})
+	_, out = do(t, s, http.MethodGet, path+"/my-kafka/topic/foo", nil)
+	retention := out["topic"].(map[string]any)["config"].(map[string]any)["retention_ms"].(map[string]any)
+	assert.Equal(t, "default_config", retention["source"])
+
+	code, _ = do(t, s, http.MethodPut, path+"/my-kafka/topic/foo", map[string]any{"partitions": 1})
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	_, out = do(t, s, http.MethodPost, path+"/my-kafka/acl", map[string]any{"permission": "read", "topic": "foo", "username": "bar"})
+	require.Len(t, out["acl"], 1)
+	id := out["acl"].([]any)[0].(map[string]any)["id"].(string)
+
+	code, _ = do(t, s, http.MethodGet, path+"/my-kafka/acl/"+id, nil)
+	assert.Equal(t, http.StatusOK, code)
+
+	_, out = do(t, s, http.MethodDelete, path+"/my-kafka/acl/"+id, nil)
+	assert.Empty(t, out["acl"])
+}
+
+func TestServiceIntegrations(t *testing.T) {
+	s := New(WithProject(testProject))
+	path := "/v1/project/" + testProject
+	do(t, s, http.MethodPost, path+"/service", map[string]any{"service_name": "my-pg", "service_type": "pg", "plan": "startup-4"})
+	do(t, s, http.MethodPost, path+"/service", map[string]any{"service_name": "my-grafana", "service_type": "grafana", "plan": "startup-1"})
+
+	code, _ := do(t, s, http.MethodPost, path+"/integration", map[string]any{
+		"integration_type": "dashboard",
+		"source_service":   "my-grafana",
+		"dest_service":     "unknown",
+	})
+	assert.Equal(t, http.StatusNotFound, code)
+
+	code, out := do(t, s, http.MethodPost, path+"/integration", map[string]any{
+		"integration_type": "dashboard",
+		"source_service":   "my-grafana",
+		"dest_service":     "my-pg",
+	})
+	require.Equal(t, http.StatusOK, code)
+	id := out["service_integration"].(map[string]any)["service_integration_id"].(string)
+
+	// Both services see the integration
+	for _, name := range []string{"my-pg", "my-grafana"} {
+		_, out = do(t, s, http.MethodGet, path+"/service/"+name+"/integration", nil)
+		assert.Len(t, out["service_integrations"], 1)
+	}
+
+	_, out = do(t, s, http.MethodGet, path+"/integration/"+id, nil)
+	assert.Equal(t, true, out["service_integration"].(map[string]any)["active"])
+
+	// Deleting the service deletes its integrations
+	do(t, s, http.MethodDelete, path+"/service/my-pg", nil)
+	code, _ = do(t, s, http.MethodGet, path+"/integration/"+id, nil)
+	assert.Equal(t, http.StatusNotFound, code)
+}
@@ -0,0 +1,488 @@
+package fakeapi
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/samber/lo"
+)
+
+const (
+	stateRebuilding = "REBUILDING"
+	stateRunning    = "RUNNING"
+	statePoweroff   = "POWEROFF"
+
+	// defaultDiskSpaceMB is the disk space of all plans
+	defaultDiskSpaceMB = 81920
+	servicePort        = 12691
+	defaultUsername    = "avnadmin"
+
+	// basePriceUSD is the hourly price of all plans
+	basePriceUSD = "0.100"
+)
+
+// publicAccessDefaults are the components of the public_access and private_access user config objects.
+// The API returns all of them when the object is set.
+var publicAccessDefaults = map[string][]string{
+	"pg":    {"pg", "pgbouncer", "prometheus"},
+	"mysql": {"mysql", "mysqlx", "prometheus"},
+	"kafka": {"kafka", "kafka_connect", "kafka_rest", "prometheus", "schema_registry"},
+}
+
+// nodeCount returns the number of nodes of all plans of the service type.
+// Kafka has three brokers, so the topics with replication 2 and 3 are valid.
+func nodeCount(serviceType string) int {
+	if serviceType == "kafka" {
+		return 3
+	}
+	return 1
+}
+
+type service struct {
+	project               string
+	name                  string
+	serviceType           string
+	plan                  string
+	cloud                 string
+	projectVPCID          *string
+	userConfig            map[string]any
+	maintenance           map[string]any
+	terminationProtection bool
+	diskSpaceMB           int
+	tags                  map[string]string
+	techEmails            []any
+	state                 string
+
+	// readsLeft is the number of reads returning REBUILDING, see WithReadsUntilRunning
+	readsLeft int
+
+	users     map[string]*serviceUser
+	databases map[string]*database
+	topics    map[string]*topic
+	acls      []*acl
+}
+
+// serviceIn is the create and update request
+type serviceIn struct {
+	ServiceName           string            `json:"service_name"`
+	ServiceType           string            `json:"service_type"`
+	Plan                  string            `json:"plan"`
+	Cloud                 string            `json:"cloud"`
+	ProjectVPCID          *string           `json:"project_vpc_id"`
+	UserConfig            map[string]any    `json:"user_config"`
+	Maintenance           map[string]any    `json:"maintenance"`
+	TerminationProtection *bool             `json:"termination_protection"`
+	DiskSpaceMB           *int              `json:"disk_space_mb"`
+	Powered               *bool             `json:"powered"`
+	Tags                  map[string]string `json:"tags"`
+	TechEmails            []any             `json:"tech_emails"`
+}
+
+// password returns a predictable password for the given parts
+func password(parts ...string) string {
+	sum := sha256.Sum256([]byte(strings.Join(parts, "/")))
+	return "AVNS_" + hex.EncodeToString(sum[:])[:20]
+}
+
+func (srv *service) host() string {
+	return fmt.Sprintf("%s-%s.fakeapi.local", srv.name, srv.project)
+}
+
+// uri returns the connection URI in the format of the service type.
+func (srv *service) uri() string {
+	hostPort := fmt.Sprintf("%s:%d", srv.host(), servicePort)
+	pwd := srv.users[defaultUsername].password
+	switch srv.serviceType {
+	case "kafka":
+		return hostPort
+	case "pg":
+		return fmt.Sprintf("postgres://%s:%s@%s/defaultdb?sslmode=require", defaultUsername, pwd, hostPort)
+	case "mysql":
+		return fmt.Sprintf("mysql://%s:%s@%s/defaultdb?ssl-mode=REQUIRED", defaultUsername, pwd, hostPort)
+	default:
+		return fmt.Sprintf("https://%s:%s@%s", defaultUsername, pwd, hostPort)
+	}
+}
+
+func (srv *service) uriParams() map[string]any {
+	params := map[string]any{
+		"host": srv.host(),
+		"port": fmt.Sprint(servicePort),
+	}
+
+	// Kafka does not provide the credentials, like the real API
+	if srv.serviceType != "kafka" {
+		params["user"] = defaultUsername
+		params["password"] = srv.users[defaultUsername].password
+	}
+
+	switch srv.serviceType {
+	case "pg", "mysql":
+		params["dbname"] = "defaultdb"
+		params["sslmode"] = "require"
+	}
+	return params
+}
+
+// setUserConfigDefaults fills the access objects of the user config, like the real API.
+func (srv *service) setUserConfigDefaults() {
+	for _, k := range []string{"public_access", "private_access"} {
+		access, ok := srv.userConfig[k].(map[string]any)
+		if !ok {
+			continue
+		}
+
+		for _, c := range publicAccessDefaults[srv.serviceType] {
+			if _, ok := access[c]; !ok {
+				access[c] = false
+			}
+		}
+	}
+}
+
+// nodeStates returns the running nodes of the running service.
+func (srv *service) nodeStates() []map[string]any {
+	nodes := make([]map[string]any, 0)
+	if srv.state != stateRunning {
+		return nodes
+	}
+
+	for i := range nodeCount(srv.serviceType) {
+		nodes = append(nodes, map[string]any{
+			"name":             fmt.Sprintf("%s-%d", srv.name, i+1),
+			"state":            "running",
+			"role":             "master",
+			"progress_updates": []any{},
+		})
+	}
+	return nodes
+}
+
+func (srv *service) metadata() map[string]any {
+	if srv.serviceType == "pg" {
+		return map[string]any{"max_connections": 100}
+	}
+	return map[string]any{}
+}
+
+func (srv *service) connectionInfo() map[string]any {
+	switch srv.serviceType {
+	case "kafka":
+		u := srv.users[defaultUsername]
+		return map[string]any{
+			"kafka":             []string{srv.uri()},
+			"kafka_access_cert": u.accessCert(),
+			"kafka_access_key":  u.accessKey(),
+		}
+	case "pg", "mysql":
+		return map[string]any{
+			srv.serviceType:             []string{srv.uri()},
+			srv.serviceType + "_params": []any{srv.uriParams()},
+		}
+	default:
+		return map[string]any{}
+	}
+}
+
+func (srv *service) out(integrations []map[string]any) map[string]any {
+	users := make([]map[string]any, 0, len(srv.users))
+	for _, k := range sortedKeys(srv.users) {
+		users = append(users, srv.users[k].out(srv.serviceType))
+	}
+
+	component := map[string]any{
+		"component": srv.serviceType,
+		"host":      srv.host(),
+		"port":      servicePort,
+		"route":     "dynamic",
+		"usage":     "primary",
+	}
+	if srv.serviceType == "kafka" {
+		component["kafka_authentication_method"] = "certificate"
+	}
+
+	backups := make([]map[string]any, 0)
+	if srv.state == stateRunning {
+		backups = append(backups, map[string]any{
+			"backup_name": srv.name + "-backup",
+			"backup_time": fakeTime,
+			"data_size":   0,
+		})
+	}
+
+	return map[string]any{
+		"service_name":           srv.name,
+		"service_type":           srv.serviceType,
+		"plan":                   srv.plan,
+		"cloud_name":             srv.cloud,
+		"project_vpc_id":         srv.projectVPCID,
+		"state":                  srv.state,
+		"create_time":            fakeTime,
+		"update_time":            fakeTime,
+		"termination_protection": srv.terminationProtection,
+		"disk_space_mb":          srv.diskSpaceMB,
+		"node_count":             nodeCount(srv.serviceType),
+		"user_config":            srv.userConfig,
+		"maintenance":            srv.maintenance,
+		"service_uri":            srv.uri(),
+		"service_uri_params":     srv.uriParams(),
+		"connection_info":        srv.connectionInfo(),
+		"components":             []any{component},
+		"users":                  users,
+		"databases":              sortedKeys(srv.databases),
+		"backups":                backups,
+		"service_integrations":   integrations,
+		"tech_emails":            srv.techEmails,
+		"metadata":               srv.metadata(),
+		"node_states":            srv.nodeStates(),
+		"service_notifications":  []any{},
+		"connection_pools":       []any{},
+	}
+}
+
+// serviceOut returns the service with its integrations.
+func (s *Server) serviceOut(srv *service) map[string]any {
+	integrations := make([]map[string]any, 0)
+	for _, i := range s.serviceIntegrations(srv.project, srv.name) {
+		integrations = append(integrations, i.out())
+	}
+	return srv.out(integrations)
+}
+
+func (s *Server) serviceList(w http.ResponseWriter, r *http.Request) {
+	p, ok := s.getProject(w, r)
+	if !ok {
+		return
+	}
+
+	services := make([]map[string]any, 0, len(p.services))
+	for _, k := range sortedKeys(p.services) {
+		services = append(services, s.serviceOut(p.services[k]))
+	}
+	writeOK(w, "services", services)
+}
+
+func (s *Server) serviceCreate(w http.ResponseWriter, r *http.Request) {
+	p, ok := s.getProject(w, r)
+	if !ok {
+		return
+	}
+
+	var req serviceIn
+	if !readJSON(w, r, &req) {
+		return
+	}
+
+	if req.ServiceName == "" || req.ServiceType == "" || req.Plan == "" {
+		writeError(w, http.StatusBadRequest, "service_name, service_type and plan are required")
+		return
+	}
+
+	if _, ok := p.services[req.ServiceName]; ok {
+		writeError(w, http.StatusConflict, "Service name is already in use in this project")
+		return
+	}
+
+	srv := &service{
+		project:               p.name,
+		name:                  req.ServiceName,
+		serviceType:           req.ServiceType,
+		plan:                  req.Plan,
+		cloud:                 lo.CoalesceOrEmpty(req.Cloud, "google-europe-west1"),
+		projectVPCID:          req.ProjectVPCID,
+		userConfig:            lo.CoalesceMapOrEmpty(req.UserConfig),
+		maintenance:           lo.CoalesceMapOrEmpty(req.Maintenance, map[string]any{"dow": "monday", "time": "10:00:00", "updates": []any{}}),
+		terminationProtection: lo.FromPtr(req.TerminationProtection),
+		diskSpaceMB:           lo.FromPtrOr(req.DiskSpaceMB, defaultDiskSpaceMB),
+		tags:                  lo.CoalesceMapOrEmpty(req.Tags),
+		techEmails:            lo.CoalesceSliceOrEmpty(req.TechEmails),
+		state:                 stateRebuilding,
+		readsLeft:             s.readsUntilRunning,
+		users:                 make(map[string]*serviceUser),
+		databases:             make(map[string]*database),
+		topics:                make(map[string]*topic),
+	}
+
+	srv.setUserConfigDefaults()
+	srv.users[defaultUsername] = newServiceUser(p.name, srv.name, defaultUsername, "primary")
+	if srv.serviceType == "pg" || srv.serviceType == "mysql" {
+		srv.databases["defaultdb"] = &database{name: "defaultdb", lcCollate: defaultLocale, lcCtype: defaultLocale}
+	}
+
+	p.services[srv.name] = srv
+	writeOK(w, "service", s.serviceOut(srv))
+}
+
+// serviceGet moves the service to the RUNNING state after the configured number of reads.
+func (s *Server) serviceGet(w http.ResponseWriter, r *http.Request) {
+	srv, ok := s.getService(w, r)
+	if !ok {
+		return
+	}
+
+	if srv.state == stateRebuilding {
+		if srv.readsLeft > 0 {
+			srv.readsLeft--
+		} else {
+			srv.state = stateRunning
+		}
+	}
+	writeOK(w, "service", s.serviceOut(srv))
+}
+
+func (s *Server) serviceUpdate(w http.ResponseWriter, r *http.Request) {
+	srv, ok := s.getService(w, r)
+	if !ok {
+		return
+	}
+
+	var req serviceIn
+	if !readJSON(w, r, &req) {
+		return
+	}
+
+	if req.Plan != "" {
+		srv.plan = req.Plan
+	}
+
+	if req.Cloud != "" {
+		srv.cloud = req.Cloud
+	}
+
+	if req.ProjectVPCID != nil {
+		srv.projectVPCID = req.ProjectVPCID
+	}
+
+	if req.UserConfig != nil {
+		// The real API merges the user config
+		for k, v := range req.UserConfig {
+			srv.userConfig[k] = v
+		}
+		srv.setUserConfigDefaults()
+	}
+
+	if req.Maintenance != nil {
+		srv.maintenance = req.Maintenance
+	}
+
+	if req.TerminationProtection != nil {
+		srv.terminationProtection = *req.TerminationProtection
+	}
+
+	if req.DiskSpaceMB != nil {
+		srv.diskSpaceMB = *req.DiskSpaceMB
+	}
+
+	if req.TechEmails != nil {
+		srv.techEmails = req.TechEmails
+	}
+
+	if req.Powered != nil {
+		switch {
+		case !*req.Powered:
+			srv.state = statePoweroff
+		case srv.state == statePoweroff:
+			srv.state = stateRebuilding
+			srv.readsLeft = s.readsUntilRunning
+		}
+	}
+
+	writeOK(w, "service", s.serviceOut(srv))
+}
+
+func (s *Server) serviceDelete(w http.ResponseWriter, r *http.Request) {
+	srv, ok := s.getService(w, r)
+	if !ok {
+		return
+	}
+
+	if srv.terminationProtection {
+		writeError(w, http.StatusForbidden, "Service is protected against termination and shutdown")
+		return
+	}
+
+	for _, i := range s.serviceIntegrations(srv.project, srv.name) {
+		delete(s.integrations, i.id)
+	}
+
+	delete(s.projects[srv.project].services, srv.name)
+	writeOK(w, "", nil)
+}
+
+func (s *Server) serviceTagsList(w http.ResponseWriter, r *http.Request) {
+	srv, ok := s.getService(w, r)
+	if !ok {
+		return
+	}
+	writeOK(w, "tags", srv.tags)
+}
+
+func (s *Server) serviceTagsReplace(w http.ResponseWriter, r *http.Request) {
+	srv, ok := s.getService(w, r)
+	if !ok {
+		return
+	}
+
+	var req struct {
+		Tags map[string]string `json:"tags"`
+	}
+
+	if !readJSON(w, r, &req) {
+		return
+	}
+
+	srv.tags = lo.CoalesceMapOrEmpty(req.Tags)
+	writeOK(w, "", nil)
+}
+
+func (s *Server) serviceConnectorList(w http.ResponseWriter, r *http.Request) {
+	if _, ok := s.getService(w, r); !ok {
+		return
+	}
+	writeOK(w, "connectors", []any{})
+}
+
+// servicePlanSpecsGet returns the same specs for all plans.
+func (s *Server) servicePlanSpecsGet(w http.ResponseWriter, r *http.Request) {
+	if _, ok := s.getProject(w, r); !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"message":            "ok",
+		"service_type":       r.PathValue("service_type"),
+		"service_plan":       r.PathValue("service_plan"),
+		"disk_space_mb":      defaultDiskSpaceMB,
+		"disk_space_cap_mb":  defaultDiskSpaceMB * 2,
+		"disk_space_step_mb": 10240,
+		"node_count":         nodeCount(r.PathValue("service_type")),
+		"shared_disk":        false,
+	})
+}
+
+// servicePlanPriceGet returns the same price for all plans and clouds.
+func (s *Server) servicePlanPriceGet(w http.ResponseWriter, r *http.Request) {
+	if _, ok := s.getProject(w, r); !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"message":                     "ok",
+		"service_type":                r.PathValue("service_type"),
+		"service_plan":                r.PathValue("service_plan"),
+		"cloud_name":                  r.PathValue("cloud"),
+		"base_price_usd":              basePriceUSD,
+		"extra_disk_price_per_gb_usd": "0.001",
+	})
+}
+
+// sortedKeys returns the map keys in order, so lists are stable.
+func sortedKeys[T any](m map[string]T) []string {
+	keys := lo.Keys(m)
+	slices.Sort(keys)
+	return keys
+}
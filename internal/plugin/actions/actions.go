@@ -0,0 +1,101 @@
+// Package actions implements the provider actions, for instance, aiven_service_power.
+// Actions run one-off operations with "terraform apply -invoke" or from a resource lifecycle.
+package actions
+
+import (
+	"context"
+	"fmt"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+
+	"github.com/aiven/terraform-provider-aiven/internal/plugin/errmsg"
+	"github.com/aiven/terraform-provider-aiven/internal/plugin/providerdata"
+)
+
+// Actions returns the provider actions.
+func Actions() []func() action.Action {
+	return []func() action.Action{
+		NewKafkaConnectorRestartAction,
+		NewServiceMaintenanceStartAction,
+		NewServicePowerAction,
+		NewServiceUserPasswordResetAction,
+	}
+}
+
+// invokeFunc runs the action with the config model T.
+// The progress sends a message to Terraform while the action is running.
+type invokeFunc[T any] func(ctx context.Context, client avngen.Client, config *T, progress func(string)) error
+
+// serviceAction implements action.Action for the given config model.
+type serviceAction[T any] struct {
+	typeName string
+	schema   schema.Schema
+	invoke   invokeFunc[T]
+	client   avngen.Client
+}
+
+var _ action.ActionWithConfigure = (*serviceAction[any])(nil)
+
+func newAction[T any](typeName string, sch schema.Schema, invoke invokeFunc[T]) action.Action {
+	return &serviceAction[T]{
+		typeName: typeName,
+		schema:   sch,
+		invoke:   invoke,
+	}
+}
+
+func (a *serviceAction[T]) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + a.typeName
+}
+
+func (a *serviceAction[T]) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = a.schema
+}
+
+func (a *serviceAction[T]) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, diags := providerdata.FromRequest(req.ProviderData)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	a.client = p.GetGenClient()
+}
+
+func (a *serviceAction[T]) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	config := new(T)
+	resp.Diagnostics.Append(req.Config.Get(ctx, config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	progress := func(msg string) {
+		resp.SendProgress(action.InvokeProgressEvent{Message: msg})
+	}
+
+	err := a.invoke(ctx, a.client, config, progress)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			errmsg.SummaryErrorInvokingAction,
+			fmt.Sprintf(errmsg.DetailErrorInvokingAction, "aiven_"+a.typeName, err.Error()),
+		)
+	}
+}
+
+// serviceAttributes returns the attributes that point to the service.
+func serviceAttributes(attrs map[string]schema.Attribute) map[string]schema.Attribute {
+	attrs["project"] = schema.StringAttribute{
+		MarkdownDescription: "The name of the project the service belongs to.",
+		Required:            true,
+	}
+	attrs["service_name"] = schema.StringAttribute{
+		MarkdownDescription: "The name of the service.",
+		Required:            true,
+	}
+	return attrs
+}
@@ -0,0 +1,203 @@
+package actions
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"github.com/aiven/go-client-codegen/handler/service"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/action/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
+)
+
+const (
+	testProject     = "test-project"
+	testServiceName = "test-service"
+)
+
+func TestActionsSchema(t *testing.T) {
+	ctx := context.Background()
+	names := make([]string, 0)
+	for _, newAction := range Actions() {
+		a := newAction()
+		metaResp := new(action.MetadataResponse)
+		a.Metadata(ctx, action.MetadataRequest{ProviderTypeName: "aiven"}, metaResp)
+		names = append(names, metaResp.TypeName)
+
+		schemaResp := new(action.SchemaResponse)
+		a.Schema(ctx, action.SchemaRequest{}, schemaResp)
+		require.Empty(t, schemaResp.Diagnostics, metaResp.TypeName)
+		assert.NotEmpty(t, schemaResp.Schema.MarkdownDescription, metaResp.TypeName)
+		assert.Contains(t, schemaResp.Schema.Attributes, "project", metaResp.TypeName)
+		assert.Contains(t, schemaResp.Schema.Attributes, "service_name", metaResp.TypeName)
+	}
+
+	expected := []string{
+		"aiven_kafka_connector_restart",
+		"aiven_service_maintenance_start",
+		"aiven_service_power",
+		"aiven_service_user_password_reset",
+	}
+	assert.Equal(t, expected, names)
+}
+
+// TestInvoke invokes the action with the config the same way Terraform does.
+func TestInvoke(t *testing.T) {
+	ctx := context.Background()
+	client := avngen.NewMockClient(t)
+	client.EXPECT().ServiceMaintenanceStart(ctx, testProject, testServiceName).Return(nil).Once()
+
+	a := &serviceAction[serviceMaintenanceStartModel]{
+		invoke: startServiceMaintenance,
+		client: client,
+	}
+
+	schemaResp := new(action.SchemaResponse)
+	NewServiceMaintenanceStartAction().Schema(ctx, action.SchemaRequest{}, schemaResp)
+
+	objType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"project":      tftypes.String,
+		"service_name": tftypes.String,
+	}}
+	req := action.InvokeRequest{
+		Config: tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw: tftypes.NewValue(objType, map[string]tftypes.Value{
+				"project":      tftypes.NewValue(tftypes.String, testProject),
+				"service_name": tftypes.NewValue(tftypes.String, testServiceName),
+			}),
+		},
+	}
+
+	messages := make([]string, 0)
+	resp := &action.InvokeResponse{
+		SendProgress: func(event action.InvokeProgressEvent) {
+			messages = append(messages, event.Message)
+		},
+	}
+
+	a.Invoke(ctx, req, resp)
+	require.Empty(t, resp.Diagnostics)
+	assert.Equal(t, []string{"Starting maintenance updates"}, messages)
+}
+
+func TestRestartKafkaConnector(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("connector", func(t *testing.T) {
+		client := avngen.NewMockClient(t)
+		client.EXPECT().ServiceKafkaConnectRestartConnector(ctx, testProject, testServiceName, "foo").Return(nil).Once()
+
+		config := &kafkaConnectorRestartModel{
+			Project:       types.StringValue(testProject),
+			ServiceName:   types.StringValue(testServiceName),
+			ConnectorName: types.StringValue("foo"),
+			TaskID:        types.Int64Null(),
+		}
+		require.NoError(t, restartKafkaConnector(ctx, client, config, func(string) {}))
+	})
+
+	t.Run("task", func(t *testing.T) {
+		client := avngen.NewMockClient(t)
+		client.EXPECT().ServiceKafkaConnectRestartConnectorTask(ctx, testProject, testServiceName, "foo", "0").Return(nil).Once()
+
+		config := &kafkaConnectorRestartModel{
+			Project:       types.StringValue(testProject),
+			ServiceName:   types.StringValue(testServiceName),
+			ConnectorName: types.StringValue("foo"),
+			TaskID:        types.Int64Value(0),
+		}
+		require.NoError(t, restartKafkaConnector(ctx, client, config, func(string) {}))
+	})
+}
+
+func TestPowerService(t *testing.T) {
+	ctx := context.Background()
+	client := avngen.NewMockClient(t)
+	client.EXPECT().
+		ServiceUpdate(ctx, testProject, testServiceName, &service.ServiceUpdateIn{Powered: lo.ToPtr(false)}).
+		Return(&service.ServiceUpdateOut{}, nil).
+		Once()
+
+	var waitedFor time.Duration
+	waitForServicePowerState = func(_ context.Context, _ avngen.Client, project, serviceName string, powered bool, timeout time.Duration) (*service.ServiceGetOut, error) {
+		assert.Equal(t, testProject, project)
+		assert.Equal(t, testServiceName, serviceName)
+		assert.False(t, powered)
+		waitedFor = timeout
+		return &service.ServiceGetOut{State: service.ServiceStateTypePoweroff}, nil
+	}
+	t.Cleanup(func() { waitForServicePowerState = schemautil.WaitForServicePowerStateByName })
+
+	config := &servicePowerModel{
+		Project:     types.StringValue(testProject),
+		ServiceName: types.StringValue(testServiceName),
+		Powered:     types.BoolValue(false),
+		Timeouts: timeouts.Value{Object: types.ObjectValueMust(
+			map[string]attr.Type{"invoke": types.StringType},
+			map[string]attr.Value{"invoke": types.StringValue("5m")},
+		)},
+	}
+
+	messages := make([]string, 0)
+	err := powerService(ctx, client, config, func(msg string) {
+		messages = append(messages, msg)
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 5*time.Minute, waitedFor)
+
+	expected := []string{
+		"Changing the service state to POWEROFF",
+		"Waiting for the service state POWEROFF up to 5m0s",
+	}
+	assert.Equal(t, expected, messages)
+}
+
+func TestResetServiceUserPassword(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("generated password", func(t *testing.T) {
+		client := avngen.NewMockClient(t)
+		client.EXPECT().
+			ServiceUserCredentialsReset(ctx, testProject, testServiceName, "avnadmin").
+			Return(&service.ServiceUserCredentialsResetOut{}, nil).
+			Once()
+
+		config := &serviceUserPasswordResetModel{
+			Project:     types.StringValue(testProject),
+			ServiceName: types.StringValue(testServiceName),
+			Username:    types.StringValue("avnadmin"),
+			Password:    types.StringNull(),
+		}
+		require.NoError(t, resetServiceUserPassword(ctx, client, config, func(string) {}))
+	})
+
+	t.Run("custom password", func(t *testing.T) {
+		client := avngen.NewMockClient(t)
+		client.EXPECT().
+			ServiceUserCredentialsModify(ctx, testProject, testServiceName, "avnadmin", &service.ServiceUserCredentialsModifyIn{
+				NewPassword: lo.ToPtr("password123"),
+				Operation:   service.ServiceUserCredentialsModifyOperationTypeResetCredentials,
+			}).
+			Return(&service.ServiceUserCredentialsModifyOut{}, nil).
+			Once()
+
+		config := &serviceUserPasswordResetModel{
+			Project:     types.StringValue(testProject),
+			ServiceName: types.StringValue(testServiceName),
+			Username:    types.StringValue("avnadmin"),
+			Password:    types.StringValue("password123"),
+		}
+		require.NoError(t, resetServiceUserPassword(ctx, client, config, func(string) {}))
+	})
+}
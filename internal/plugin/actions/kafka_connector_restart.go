@@ -0,0 +1,52 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type kafkaConnectorRestartModel struct {
+	Project       types.String `tfsdk:"project"`
+	ServiceName   types.String `tfsdk:"service_name"`
+	ConnectorName types.String `tfsdk:"connector_name"`
+	TaskID        types.Int64  `tfsdk:"task_id"`
+}
+
+func NewKafkaConnectorRestartAction() action.Action {
+	return newAction("kafka_connector_restart", schema.Schema{
+		MarkdownDescription: "Restarts a Kafka® connector or one of its tasks, for instance, a failed one.",
+		Attributes: serviceAttributes(map[string]schema.Attribute{
+			"connector_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the connector.",
+				Required:            true,
+			},
+			"task_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the task to restart. Restarts the connector when not set.",
+				Optional:            true,
+				Validators:          []validator.Int64{int64validator.AtLeast(0)},
+			},
+		}),
+	}, restartKafkaConnector)
+}
+
+func restartKafkaConnector(ctx context.Context, client avngen.Client, config *kafkaConnectorRestartModel, progress func(string)) error {
+	project := config.Project.ValueString()
+	serviceName := config.ServiceName.ValueString()
+	connectorName := config.ConnectorName.ValueString()
+	if config.TaskID.IsNull() {
+		progress(fmt.Sprintf("Restarting connector %q", connectorName))
+		return client.ServiceKafkaConnectRestartConnector(ctx, project, serviceName, connectorName)
+	}
+
+	taskID := strconv.FormatInt(config.TaskID.ValueInt64(), 10)
+	progress(fmt.Sprintf("Restarting task %s of connector %q", taskID, connectorName))
+	return client.ServiceKafkaConnectRestartConnectorTask(ctx, project, serviceName, connectorName, taskID)
+}
@@ -0,0 +1,31 @@
+package actions
+
+import (
+	"context"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type serviceMaintenanceStartModel struct {
+	Project     types.String `tfsdk:"project"`
+	ServiceName types.String `tfsdk:"service_name"`
+}
+
+func NewServiceMaintenanceStartAction() action.Action {
+	return newAction("service_maintenance_start", schema.Schema{
+		MarkdownDescription: "Starts the pending maintenance updates of a service now, " +
+			"instead of waiting for the [maintenance window](https://aiven.io/docs/platform/concepts/maintenance-window). " +
+			"For PostgreSQL®, the updates move the service to new nodes with a failover. " +
+			"The provider has no separate failover and backup actions: the API has no endpoints to trigger them, " +
+			"the backups run on the schedule set with `pg_user_config.backup_hour` and `pg_user_config.backup_minute`.",
+		Attributes: serviceAttributes(map[string]schema.Attribute{}),
+	}, startServiceMaintenance)
+}
+
+func startServiceMaintenance(ctx context.Context, client avngen.Client, config *serviceMaintenanceStartModel, progress func(string)) error {
+	progress("Starting maintenance updates")
+	return client.ServiceMaintenanceStart(ctx, config.Project.ValueString(), config.ServiceName.ValueString())
+}
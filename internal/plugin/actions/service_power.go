@@ -0,0 +1,73 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"github.com/aiven/go-client-codegen/handler/service"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/action/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
+)
+
+// defaultPowerTimeout is the same as the default update timeout of the service resources
+const defaultPowerTimeout = 20 * time.Minute
+
+// waitForServicePowerState is replaced in tests, the state change checks take minutes
+var waitForServicePowerState = schemautil.WaitForServicePowerStateByName
+
+type servicePowerModel struct {
+	Project     types.String   `tfsdk:"project"`
+	ServiceName types.String   `tfsdk:"service_name"`
+	Powered     types.Bool     `tfsdk:"powered"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+func NewServicePowerAction() action.Action {
+	return newAction("service_power", schema.Schema{
+		MarkdownDescription: "Powers a service [on or off](https://aiven.io/docs/platform/concepts/service-power-cycle) " +
+			"and waits for it to reach the `RUNNING` or `POWEROFF` state. " +
+			"Powering off a service deletes its data that is not backed up.",
+		Attributes: serviceAttributes(map[string]schema.Attribute{
+			"powered": schema.BoolAttribute{
+				MarkdownDescription: "Powers the service on when `true`, powers it off when `false`.",
+				Required:            true,
+			},
+		}),
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockWithOpts(context.Background(), timeouts.Opts{
+				InvokeDescription: "How long to wait for the service state, for instance, `30m`. The default value is `20m`.",
+			}),
+		},
+	}, powerService)
+}
+
+func powerService(ctx context.Context, client avngen.Client, config *servicePowerModel, progress func(string)) error {
+	project := config.Project.ValueString()
+	serviceName := config.ServiceName.ValueString()
+	powered := config.Powered.ValueBool()
+	target := service.ServiceStateTypeRunning
+	if !powered {
+		target = service.ServiceStateTypePoweroff
+	}
+
+	timeout, diags := config.Timeouts.Invoke(ctx, defaultPowerTimeout)
+	if diags.HasError() {
+		return fmt.Errorf("invalid timeouts: %s", diags.Errors()[0].Detail())
+	}
+
+	progress(fmt.Sprintf("Changing the service state to %s", target))
+	_, err := client.ServiceUpdate(ctx, project, serviceName, &service.ServiceUpdateIn{Powered: &powered})
+	if err != nil {
+		return err
+	}
+
+	progress(fmt.Sprintf("Waiting for the service state %s up to %s", target, timeout))
+	_, err = waitForServicePowerState(ctx, client, project, serviceName, powered, timeout)
+	return err
+}
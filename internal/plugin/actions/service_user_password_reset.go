@@ -0,0 +1,62 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"github.com/aiven/go-client-codegen/handler/service"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type serviceUserPasswordResetModel struct {
+	Project     types.String `tfsdk:"project"`
+	ServiceName types.String `tfsdk:"service_name"`
+	Username    types.String `tfsdk:"username"`
+	Password    types.String `tfsdk:"password"`
+}
+
+func NewServiceUserPasswordResetAction() action.Action {
+	return newAction("service_user_password_reset", schema.Schema{
+		MarkdownDescription: "Resets the password of a service user, for instance, `avnadmin`. " +
+			"The user resources and data sources read the new password on the next refresh.",
+		Attributes: serviceAttributes(map[string]schema.Attribute{
+			"username": schema.StringAttribute{
+				MarkdownDescription: "The name of the service user.",
+				Required:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "The new password. Generates a random password when not set. " +
+					"The password is never stored in the plan or state.",
+				Optional:   true,
+				WriteOnly:  true,
+				Validators: []validator.String{stringvalidator.LengthBetween(8, 256)},
+			},
+		}),
+	}, resetServiceUserPassword)
+}
+
+// resetServiceUserPassword works the same way as schemautil.UpsertPassword
+func resetServiceUserPassword(ctx context.Context, client avngen.Client, config *serviceUserPasswordResetModel, progress func(string)) error {
+	project := config.Project.ValueString()
+	serviceName := config.ServiceName.ValueString()
+	username := config.Username.ValueString()
+	progress(fmt.Sprintf("Resetting the password of %q", username))
+
+	if config.Password.ValueString() == "" { // auto-generate password
+		_, err := client.ServiceUserCredentialsReset(ctx, project, serviceName, username)
+		return err
+	}
+
+	password := config.Password.ValueString()
+	_, err := client.ServiceUserCredentialsModify(ctx, project, serviceName, username,
+		&service.ServiceUserCredentialsModifyIn{
+			NewPassword: &password,
+			Operation:   service.ServiceUserCredentialsModifyOperationTypeResetCredentials,
+		})
+	return err
+}
@@ -0,0 +1,194 @@
+package adapter
+
+import (
+	"context"
+	"fmt"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+
+	"github.com/aiven/terraform-provider-aiven/internal/plugin/providerdata"
+	"github.com/aiven/terraform-provider-aiven/internal/plugin/util"
+)
+
+// EphemeralResourceOptions describes an ephemeral resource.
+// Ephemeral resources are never persisted in the plan or state,
+// which makes them suitable for reading server-generated secrets.
+type EphemeralResourceOptions struct {
+	// TypeName is the name of ephemeral resource,
+	// for instance, "aiven_pg_user_credentials"
+	TypeName       string
+	Schema         func(ctx context.Context) schema.Schema
+	SchemaInternal *Schema
+
+	// Indicates whether the ephemeral resource is in beta.
+	// Requires the `PROVIDER_AIVEN_ENABLE_BETA` environment variable to be set.
+	Beta bool
+
+	// IDFields are used to build the resource ID.
+	// Example: ["project", "service_name", "username"] == "project/service_name/username"
+	IDFields []string
+
+	// Open is the only operation for ephemeral resource.
+	// Has the same signature as the datasource Read, so the same view can be reused.
+	Open func(ctx context.Context, client avngen.Client, d ResourceData) error
+
+	// ValidateConfig implements ephemeral.EphemeralResourceWithValidateConfig.
+	// https://developer.hashicorp.com/terraform/plugin/framework/ephemeral-resources/validate-configuration#validateconfig-method
+	ValidateConfig func(ctx context.Context, client avngen.Client, d ResourceData) error
+
+	// ConfigValidators implements ephemeral.EphemeralResourceWithConfigValidators.
+	// https://developer.hashicorp.com/terraform/plugin/framework/ephemeral-resources/validate-configuration#configvalidators-method
+	ConfigValidators func(ctx context.Context, client avngen.Client) []ephemeral.ConfigValidator
+}
+
+func NewEphemeralResource(options EphemeralResourceOptions) ephemeral.EphemeralResource {
+	return &ephemeralAdapter{
+		ephemeral: options,
+	}
+}
+
+// NewLazyEphemeralResource creates a lazy ephemeral resource constructor.
+// The provider.ProviderWithEphemeralResources.EphemeralResources requires a function that returns an ephemeral.EphemeralResource.
+func NewLazyEphemeralResource(options EphemeralResourceOptions) func() ephemeral.EphemeralResource {
+	return func() ephemeral.EphemeralResource {
+		return NewEphemeralResource(options)
+	}
+}
+
+var (
+	_ ephemeral.EphemeralResource                     = (*ephemeralAdapter)(nil)
+	_ ephemeral.EphemeralResourceWithConfigure        = (*ephemeralAdapter)(nil)
+	_ ephemeral.EphemeralResourceWithValidateConfig   = (*ephemeralAdapter)(nil)
+	_ ephemeral.EphemeralResourceWithConfigValidators = (*ephemeralAdapter)(nil)
+)
+
+type ephemeralAdapter struct {
+	client    avngen.Client
+	ephemeral EphemeralResourceOptions
+}
+
+func (a *ephemeralAdapter) Configure(
+	_ context.Context,
+	req ephemeral.ConfigureRequest,
+	rsp *ephemeral.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		// TF calls Configure many times, it might not contain the provider data yet.
+		return
+	}
+
+	p, diags := providerdata.FromRequest(req.ProviderData)
+	if diags.HasError() {
+		rsp.Diagnostics.Append(diags...)
+		return
+	}
+
+	a.client = p.GetGenClient()
+}
+
+func (a *ephemeralAdapter) Metadata(
+	_ context.Context,
+	_ ephemeral.MetadataRequest,
+	rsp *ephemeral.MetadataResponse,
+) {
+	rsp.TypeName = a.ephemeral.TypeName
+}
+
+func (a *ephemeralAdapter) Schema(
+	ctx context.Context,
+	_ ephemeral.SchemaRequest,
+	rsp *ephemeral.SchemaResponse,
+) {
+	rsp.Schema = a.ephemeral.Schema(ctx)
+}
+
+func (a *ephemeralAdapter) Open(
+	ctx context.Context,
+	req ephemeral.OpenRequest,
+	rsp *ephemeral.OpenResponse,
+) {
+	diags := &rsp.Diagnostics
+
+	// Ephemeral resources are read-only, like datasources.
+	d, err := NewResourceData(a.ephemeral.SchemaInternal, a.ephemeral.IDFields,
+		WithIsDataSource(),
+		WithConfig(req.Config),
+	)
+	if err != nil {
+		diags.AddError("failed to create ResourceData", err.Error())
+		return
+	}
+
+	ctx, cancel, err := withTimeout(ctx, d, timeoutOpen)
+	if err != nil {
+		diags.AddError("failed to read timeouts block", err.Error())
+		return
+	}
+	defer cancel()
+
+	ctx, drainWarnings := withWarnings(ctx, diags)
+	defer drainWarnings()
+
+	err = a.ephemeral.Open(ctx, a.client, d)
+	if err != nil {
+		diags.AddError("failed to open ephemeral resource", err.Error())
+		return
+	}
+
+	rsp.Result.Raw = d.tfValue()
+}
+
+func (a *ephemeralAdapter) ValidateConfig(
+	ctx context.Context,
+	req ephemeral.ValidateConfigRequest,
+	rsp *ephemeral.ValidateConfigResponse,
+) {
+	if a.ephemeral.Beta && !util.IsBeta() {
+		rsp.Diagnostics.AddError(
+			"Beta Ephemeral Resource Not Enabled",
+			fmt.Sprintf("The `%s` ephemeral resource is in beta. Set the `%s=true` environment variable to enable.", a.ephemeral.TypeName, util.AivenEnableBeta),
+		)
+		return
+	}
+
+	if a.ephemeral.ValidateConfig == nil || a.client == nil {
+		// The first ValidateConfig call goes before Configure, hence the client might be nil.
+		return
+	}
+
+	diags := &rsp.Diagnostics
+
+	d, err := NewResourceData(a.ephemeral.SchemaInternal, a.ephemeral.IDFields,
+		WithIsDataSource(),
+		WithConfig(req.Config),
+	)
+	if err != nil {
+		diags.AddError("failed to create ResourceData", err.Error())
+		return
+	}
+
+	ctx, cancel, err := withTimeout(ctx, d, timeoutOpen)
+	if err != nil {
+		diags.AddError("failed to read timeouts block", err.Error())
+		return
+	}
+	defer cancel()
+
+	ctx, drainWarnings := withWarnings(ctx, diags)
+	defer drainWarnings()
+
+	err = a.ephemeral.ValidateConfig(ctx, a.client, d)
+	if err != nil {
+		diags.AddError("failed to validate config", err.Error())
+		return
+	}
+}
+
+func (a *ephemeralAdapter) ConfigValidators(ctx context.Context) []ephemeral.ConfigValidator {
+	if a.ephemeral.ConfigValidators == nil {
+		return nil
+	}
+	return a.ephemeral.ConfigValidators(ctx, a.client)
+}
@@ -0,0 +1,204 @@
+package adapter
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/aiven/terraform-provider-aiven/internal/plugin/providerdata"
+	"github.com/aiven/terraform-provider-aiven/internal/plugin/util"
+	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
+)
+
+// listReadConcurrency limits parallel Read calls when "include_resource" is set.
+// Some repositories batch parallel reads, see kafkatopicrepository.
+const listReadConcurrency = 50
+
+// NewListResource creates a list resource for the resource with ResourceOptions.List.
+func NewListResource(options ResourceOptions) list.ListResource {
+	return &listAdapter{
+		resource: options,
+	}
+}
+
+// NewLazyListResource creates a lazy list resource constructor.
+// The provider.ProviderWithListResources.ListResources requires a function that returns a list.ListResource.
+func NewLazyListResource(options ResourceOptions) func() list.ListResource {
+	return func() list.ListResource {
+		return NewListResource(options)
+	}
+}
+
+var (
+	_ list.ListResource              = (*listAdapter)(nil)
+	_ list.ListResourceWithConfigure = (*listAdapter)(nil)
+)
+
+type listAdapter struct {
+	client   avngen.Client
+	resource ResourceOptions
+}
+
+func (a *listAdapter) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	rsp *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		// TF calls Configure many times, it might not contain the provider data yet.
+		return
+	}
+
+	p, diags := providerdata.FromRequest(req.ProviderData)
+	if diags.HasError() {
+		rsp.Diagnostics.Append(diags...)
+		return
+	}
+
+	a.client = p.GetGenClient()
+}
+
+func (a *listAdapter) Metadata(
+	_ context.Context,
+	_ resource.MetadataRequest,
+	rsp *resource.MetadataResponse,
+) {
+	rsp.TypeName = a.resource.TypeName
+}
+
+// configFields returns the list config fields: the ID fields without the last one,
+// which is the resource "name", for instance, "topic_name".
+func (a *listAdapter) configFields() []string {
+	return a.resource.IDFields[:len(a.resource.IDFields)-1]
+}
+
+func (a *listAdapter) ListResourceConfigSchema(
+	ctx context.Context,
+	_ list.ListResourceSchemaRequest,
+	rsp *list.ListResourceSchemaResponse,
+) {
+	resourceSchema := a.resource.Schema(ctx)
+	attrs := make(map[string]listschema.Attribute)
+	for _, k := range a.configFields() {
+		attrs[k] = listschema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: resourceSchema.Attributes[k].GetMarkdownDescription(),
+		}
+	}
+
+	rsp.Schema = listschema.Schema{
+		Attributes:          attrs,
+		MarkdownDescription: fmt.Sprintf("Lists `%s` resources.", a.resource.TypeName),
+	}
+}
+
+// configSchemaInternal returns the internal schema of the list config.
+func (a *listAdapter) configSchemaInternal() *Schema {
+	props := make(map[string]*Schema)
+	for _, k := range a.configFields() {
+		props[k] = &Schema{Type: SchemaTypeString}
+	}
+	return &Schema{Type: SchemaTypeObject, Properties: props}
+}
+
+func (a *listAdapter) List(
+	ctx context.Context,
+	req list.ListRequest,
+	stream *list.ListResultsStream,
+) {
+	var diags diag.Diagnostics
+	if a.resource.Beta && !util.IsBeta() {
+		diags.AddError(
+			"Beta List Resource Not Enabled",
+			fmt.Sprintf("The `%s` list resource is in beta. Set the `%s=true` environment variable to enable.", a.resource.TypeName, util.AivenEnableBeta),
+		)
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	config, err := NewResourceData(a.configSchemaInternal(), a.configFields(),
+		WithIsDataSource(),
+		WithConfig(req.Config),
+	)
+	if err != nil {
+		diags.AddError("failed to create ResourceData", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	ids, err := a.resource.List(ctx, a.client, config)
+	if err != nil {
+		diags.AddError("failed to list resources", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	if req.Limit > 0 && int64(len(ids)) > req.Limit {
+		ids = ids[:req.Limit]
+	}
+
+	results := make([]list.ListResult, len(ids))
+	sem := make(chan struct{}, listReadConcurrency)
+	var wg sync.WaitGroup
+	for i, id := range ids {
+		wg.Go(func() {
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			results[i] = a.listResult(ctx, req, id)
+		})
+	}
+	wg.Wait()
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, r := range results {
+			if !push(r) {
+				return
+			}
+		}
+	}
+}
+
+// listResult builds the identity and, when requested, reads the resource.
+func (a *listAdapter) listResult(ctx context.Context, req list.ListRequest, id string) list.ListResult {
+	result := req.NewListResult(ctx)
+	result.DisplayName = id
+
+	parts, err := schemautil.SplitResourceID(id, len(a.resource.IDFields))
+	if err != nil {
+		result.Diagnostics.AddError("invalid resource ID", err.Error())
+		return result
+	}
+
+	for i, k := range a.resource.IDFields {
+		result.Diagnostics.Append(result.Identity.SetAttribute(ctx, path.Root(k), parts[i])...)
+	}
+
+	if !req.IncludeResource || result.Diagnostics.HasError() {
+		return result
+	}
+
+	d, err := NewResourceData(a.resource.SchemaInternal, a.resource.IDFields, WithIDState(parts...))
+	if err != nil {
+		result.Diagnostics.AddError("failed to create ResourceData", err.Error())
+		return result
+	}
+
+	ctx, drainWarnings := withWarnings(ctx, &result.Diagnostics)
+	defer drainWarnings()
+
+	err = a.resource.Read(ctx, a.client, d)
+	if err != nil {
+		result.Diagnostics.AddError("failed to read resource", err.Error())
+		return result
+	}
+
+	result.Resource.Raw = d.tfValue()
+	return result
+}
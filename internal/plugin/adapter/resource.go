@@ -14,7 +14,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/aiven/terraform-provider-aiven/internal/plugin/errmsg"
@@ -122,12 +124,24 @@ type ResourceOptions struct {
 	// ConfigValidators implements resource.ResourceWithConfigValidators.
 	// https://developer.hashicorp.com/terraform/plugin/framework/resources/validate-configuration#configvalidators-method
 	ConfigValidators func(ctx context.Context, client avngen.Client) []resource.ConfigValidator
+
+	// List implements list.ListResource for "terraform query", see NewListResource.
+	// Also enables the resource identity built from IDFields, which "terraform query" requires.
+	// Receives the list config with all IDFields but the last one, for instance, "project" and "service_name".
+	// Returns the IDs of the found resources, see schemautil.BuildResourceID.
+	// When "include_resource" is set, the Read operation populates each found resource.
+	List func(ctx context.Context, client avngen.Client, d ResourceData) ([]string, error)
 }
 
 func NewResource(options ResourceOptions) resource.Resource {
-	return &resourceAdapter{
+	a := &resourceAdapter{
 		resource: options,
 	}
+
+	if options.List != nil {
+		return &resourceWithIdentityAdapter{a}
+	}
+	return a
 }
 
 // NewLazyResource creates a lazy resource constructor.
@@ -228,6 +242,7 @@ func (a *resourceAdapter) Create(
 		stateCheckpointed := createSucceeded && ensurePostCreateID(d, a.resource.IDFields)
 		if stateCheckpointed {
 			rsp.State.Raw = d.tfValue()
+			diags.Append(a.setIdentity(ctx, rsp.Identity, d)...)
 		}
 
 		err = a.refreshState(ctx, d)
@@ -236,6 +251,7 @@ func (a *resourceAdapter) Create(
 			// polling. Preserve it without replacing an earlier, known-good checkpoint.
 			if createSucceeded && !stateCheckpointed && ensurePostCreateID(d, a.resource.IDFields) {
 				rsp.State.Raw = d.tfValue()
+				diags.Append(a.setIdentity(ctx, rsp.Identity, d)...)
 			}
 			diags.AddError("failed to refresh state", err.Error())
 			return
@@ -243,6 +259,7 @@ func (a *resourceAdapter) Create(
 	}
 
 	rsp.State.Raw = d.tfValue()
+	diags.Append(a.setIdentity(ctx, rsp.Identity, d)...)
 }
 
 // ensurePostCreateID reports whether d has a complete ID that is safe to checkpoint. Generated
@@ -300,6 +317,13 @@ func (a *resourceAdapter) Read(
 	// When RemoveMissing is enabled, we remove the resource from the state if it's missing.
 	// See ResourceOptions.RemoveMissing for more details.
 	err = a.resource.Read(ctx, a.client, d)
+	if err != nil && a.isServicePoweredOff(ctx, d, err) {
+		// Keeps the previous state, see schemautil.IsServicePoweredOff.
+		project, serviceName := d.Get("project").(string), d.Get("service_name").(string)
+		AddWarning(ctx, errmsg.SummaryServicePoweredOff, fmt.Sprintf(errmsg.DetailServicePoweredOff, project, serviceName, d.ID()))
+		return
+	}
+
 	if a.resource.RemoveMissing && IsNotFound(err) {
 		// Ignores all the other diagnostics and removes the resource from the state.
 		rsp.State.RemoveResource(ctx)
@@ -312,6 +336,22 @@ func (a *resourceAdapter) Read(
 	}
 
 	rsp.State.Raw = d.tfValue()
+	diags.Append(a.setIdentity(ctx, rsp.Identity, d)...)
+}
+
+// isServicePoweredOff returns true when the resource belongs to a service that is powered off.
+// A new resource has no previous state to keep, so the error is returned as is.
+func (a *resourceAdapter) isServicePoweredOff(ctx context.Context, d ResourceData, err error) bool {
+	if d.IsNewResource() {
+		return false
+	}
+
+	if !slices.Contains(a.resource.IDFields, "project") || !slices.Contains(a.resource.IDFields, "service_name") {
+		return false
+	}
+
+	project, serviceName := d.Get("project").(string), d.Get("service_name").(string)
+	return schemautil.IsServicePoweredOff(ctx, a.client, project, serviceName, err)
 }
 
 // ErrRefreshStateDesired indicates a refresh attribute did not match any configured desired value.
@@ -474,6 +514,9 @@ func (a *resourceAdapter) Update(
 		}
 	}
 
+	// The ID might be planned unknown, for instance, by ModifyPlanDefaultTags to mark the resource for update
+	ensurePostCreateID(d, a.resource.IDFields)
+
 	if a.resource.RefreshState != nil {
 		err = a.refreshState(ctx, d)
 		if err != nil {
@@ -483,6 +526,7 @@ func (a *resourceAdapter) Update(
 	}
 
 	rsp.State.Raw = d.tfValue()
+	diags.Append(a.setIdentity(ctx, rsp.Identity, d)...)
 }
 
 func (a *resourceAdapter) Delete(
@@ -647,6 +691,16 @@ func (a *resourceAdapter) ImportState(
 	req resource.ImportStateRequest,
 	rsp *resource.ImportStateResponse,
 ) {
+	// Imported with the "identity" attribute of the import block
+	if req.ID == "" && req.Identity != nil {
+		for _, k := range a.resource.IDFields {
+			var v string
+			rsp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root(k), &v)...)
+			rsp.Diagnostics.Append(rsp.State.SetAttribute(ctx, path.Root(k), v)...)
+		}
+		return
+	}
+
 	// Set only ID fields here; Terraform runs Read afterward to populate full state.
 	values, err := schemautil.SplitResourceID(req.ID, len(a.resource.IDFields))
 	if err != nil {
@@ -660,7 +714,49 @@ func (a *resourceAdapter) ImportState(
 
 	for i, v := range values {
 		rsp.Diagnostics.Append(rsp.State.SetAttribute(ctx, path.Root(a.resource.IDFields[i]), v)...)
+		if rsp.Identity != nil {
+			rsp.Diagnostics.Append(rsp.Identity.SetAttribute(ctx, path.Root(a.resource.IDFields[i]), v)...)
+		}
+	}
+}
+
+// resourceWithIdentityAdapter is a resourceAdapter with the identity built from IDFields.
+// Terraform stores the identity in the state once it is declared, so it is enabled for listable resources only.
+type resourceWithIdentityAdapter struct {
+	*resourceAdapter
+}
+
+var _ resource.ResourceWithIdentity = (*resourceWithIdentityAdapter)(nil)
+
+func (a *resourceWithIdentityAdapter) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	rsp *resource.IdentitySchemaResponse,
+) {
+	rsp.IdentitySchema = identitySchema(a.resource.IDFields)
+}
+
+// identitySchema returns the identity schema: all ID fields are required for import.
+func identitySchema(idFields []string) identityschema.Schema {
+	attrs := make(map[string]identityschema.Attribute, len(idFields))
+	for _, k := range idFields {
+		attrs[k] = identityschema.StringAttribute{RequiredForImport: true}
+	}
+	return identityschema.Schema{Attributes: attrs}
+}
+
+// setIdentity sets the resource identity from the ID fields.
+// The identity is nil when the resource doesn't support it, see resourceWithIdentityAdapter.
+func (a *resourceAdapter) setIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, d ResourceData) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if identity == nil {
+		return diags
+	}
+
+	for _, k := range a.resource.IDFields {
+		diags.Append(identity.SetAttribute(ctx, path.Root(k), fmt.Sprint(d.Get(k)))...)
 	}
+	return diags
 }
 
 func (a *resourceAdapter) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
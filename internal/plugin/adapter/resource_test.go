@@ -14,6 +14,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/stretchr/testify/require"
+
+	"github.com/aiven/terraform-provider-aiven/internal/plugin/errmsg"
+	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
 )
 
 func TestResourceAdapterCreatePreservesStateAfterRefreshError(t *testing.T) {
@@ -1005,6 +1008,74 @@ func TestResourceAdapter_deleteState(t *testing.T) {
 	})
 }
 
+func TestResourceAdapterReadServicePoweredOff(t *testing.T) {
+	schema := &Schema{
+		Type: SchemaTypeObject,
+		Properties: map[string]*Schema{
+			"id":            {Type: SchemaTypeString, Computed: true},
+			"project":       {Type: SchemaTypeString},
+			"service_name":  {Type: SchemaTypeString},
+			"database_name": {Type: SchemaTypeString},
+		},
+	}
+	idFields := []string{"project", "service_name", "database_name"}
+	readErr := fmt.Errorf("failed to list databases: %w", schemautil.ErrServicePoweredOff)
+
+	tests := []struct {
+		name     string
+		idFields []string
+		state    map[string]any
+		want     bool
+	}{
+		{
+			name:     "existing resource keeps the state",
+			idFields: idFields,
+			state:    map[string]any{"id": "p/s/db", "project": "p", "service_name": "s", "database_name": "db"},
+			want:     true,
+		},
+		{
+			name:     "new resource gets the error",
+			idFields: idFields,
+			state:    map[string]any{"project": "p", "service_name": "s", "database_name": "db"},
+			want:     false,
+		},
+		{
+			name:     "not a service resource",
+			idFields: []string{"project", "database_name"},
+			state:    map[string]any{"id": "p/db", "project": "p", "database_name": "db"},
+			want:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := &resourceAdapter{resource: ResourceOptions{SchemaInternal: schema, IDFields: tt.idFields}}
+			d, err := NewResourceData(schema, tt.idFields, WithTestState(tt.state))
+			require.NoError(t, err)
+			require.Equal(t, tt.want, a.isServicePoweredOff(t.Context(), d, readErr))
+		})
+	}
+
+	t.Run("read keeps the state with a warning", func(t *testing.T) {
+		a := &resourceAdapter{resource: ResourceOptions{
+			SchemaInternal: schema,
+			IDFields:       idFields,
+			Read: func(context.Context, avngen.Client, ResourceData) error {
+				return readErr
+			},
+		}}
+		raw, err := toTFValue(schema, tests[0].state)
+		require.NoError(t, err)
+
+		rsp := resource.ReadResponse{State: tfsdk.State{Raw: raw}}
+		a.Read(t.Context(), resource.ReadRequest{State: tfsdk.State{Raw: raw}}, &rsp)
+		require.False(t, rsp.Diagnostics.HasError())
+		require.Len(t, rsp.Diagnostics.Warnings(), 1)
+		require.Equal(t, errmsg.SummaryServicePoweredOff, rsp.Diagnostics.Warnings()[0].Summary())
+		require.True(t, raw.Equal(rsp.State.Raw))
+	})
+}
+
 func TestIsRefreshStateRetryable(t *testing.T) {
 	t.Parallel()
 
@@ -540,6 +540,25 @@ func WithIsDataSource() ResourceDataOpt {
 	}
 }
 
+// WithIDState sets the state with the ID fields only, the same way "terraform import" does.
+// The Read operation populates the rest.
+func WithIDState(parts ...string) ResourceDataOpt {
+	return func(d *resourceData) error {
+		if len(parts) != len(d.idFields) {
+			return fmt.Errorf("expected %d ID parts, got %d", len(d.idFields), len(parts))
+		}
+
+		d.state = make(map[string]any, len(parts)+1)
+		for i, k := range d.idFields {
+			err := d.Set(k, parts[i])
+			if err != nil {
+				return err
+			}
+		}
+		return d.SetID(parts...)
+	}
+}
+
 // WithTestPlan sets the plan from a map. For tests only.
 func WithTestPlan(plan map[string]any) ResourceDataOpt {
 	return func(d *resourceData) error {
@@ -0,0 +1,445 @@
+package adapter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
+)
+
+// SDKDataSourceOptions returns the options of a data source that keeps the schema of the SDK data source.
+// It's a migration step for the data sources that share the schema and the read function with the SDK resources,
+// for instance, aiven_pg: the schema and the state stay the same, see NewSDKResourceData.
+//
+// The nested SDK blocks become computed nested attributes:
+// the SDK shows the computed blocks of a data source as attributes too, so the references don't change.
+// The fields of the unsupported SDK types are skipped, and the data source returns the error on validate and read.
+func SDKDataSourceOptions(
+	typeName string,
+	r *sdkschema.Resource,
+	read func(ctx context.Context, client avngen.Client, d schemautil.ResourceData) diag.Diagnostics,
+) DataSourceOptions {
+	s := withSDKID(r.Schema)
+	attributes, errAttributes := sdkDataSourceAttributes(s, false, false)
+	properties, errProperties := sdkSchemaProperties(s)
+	errSchema := errors.Join(errAttributes, errProperties)
+	if errSchema != nil {
+		errSchema = fmt.Errorf("invalid %s schema: %w", typeName, errSchema)
+	}
+
+	return DataSourceOptions{
+		TypeName: typeName,
+		Schema: func(context.Context) schema.Schema {
+			return schema.Schema{
+				MarkdownDescription: r.Description,
+				Attributes:          attributes,
+			}
+		},
+		SchemaInternal: &Schema{
+			Type:       SchemaTypeObject,
+			Properties: properties,
+		},
+		ValidateConfig: func(context.Context, avngen.Client, ResourceData) error {
+			return errSchema
+		},
+		Read: func(ctx context.Context, client avngen.Client, d ResourceData) error {
+			if errSchema != nil {
+				return errSchema
+			}
+
+			sd := &sdkResourceData{d: d}
+			err := fromSDKDiagnostics(ctx, read(ctx, client, sd))
+			return errors.Join(err, sd.err)
+		},
+	}
+}
+
+// withSDKID adds the "id" field the SDK adds to every resource and data source.
+func withSDKID(s map[string]*sdkschema.Schema) map[string]*sdkschema.Schema {
+	if _, ok := s[idField]; ok {
+		return s
+	}
+
+	result := make(map[string]*sdkschema.Schema, len(s)+1)
+	for k, v := range s {
+		result[k] = v
+	}
+	result[idField] = &sdkschema.Schema{
+		Type:        sdkschema.TypeString,
+		Computed:    true,
+		Description: "The ID of this resource.",
+	}
+	return result
+}
+
+// sdkDataSourceAttributes converts the SDK schema to the data source attributes.
+// The nested fields of a computed field are computed, and the nested fields of a sensitive field are sensitive.
+// Returns the attributes of the supported fields and the errors of the others.
+func sdkDataSourceAttributes(s map[string]*sdkschema.Schema, computed, sensitive bool) (map[string]schema.Attribute, error) {
+	result := make(map[string]schema.Attribute, len(s))
+	var errs []error
+	for k, v := range s {
+		a, err := sdkDataSourceAttribute(v, computed, sensitive)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("field %q: %w", k, err))
+		}
+		if a != nil {
+			result[k] = a
+		}
+	}
+	return result, errors.Join(errs...)
+}
+
+func sdkDataSourceAttribute(s *sdkschema.Schema, computed, sensitive bool) (schema.Attribute, error) {
+	required, optional := s.Required, s.Optional
+	if computed {
+		required, optional = false, false
+	}
+	computed = computed || s.Computed
+	sensitive = sensitive || s.Sensitive
+
+	switch s.Type {
+	case sdkschema.TypeString:
+		return schema.StringAttribute{
+			MarkdownDescription: s.Description,
+			Required:            required,
+			Optional:            optional,
+			Computed:            computed,
+			Sensitive:           sensitive,
+		}, nil
+	case sdkschema.TypeInt:
+		return schema.Int64Attribute{
+			MarkdownDescription: s.Description,
+			Required:            required,
+			Optional:            optional,
+			Computed:            computed,
+			Sensitive:           sensitive,
+		}, nil
+	case sdkschema.TypeFloat:
+		return schema.Float64Attribute{
+			MarkdownDescription: s.Description,
+			Required:            required,
+			Optional:            optional,
+			Computed:            computed,
+			Sensitive:           sensitive,
+		}, nil
+	case sdkschema.TypeBool:
+		return schema.BoolAttribute{
+			MarkdownDescription: s.Description,
+			Required:            required,
+			Optional:            optional,
+			Computed:            computed,
+			Sensitive:           sensitive,
+		}, nil
+	}
+
+	if r, ok := s.Elem.(*sdkschema.Resource); ok {
+		attributes, err := sdkDataSourceAttributes(r.Schema, computed, sensitive)
+		nested := schema.NestedAttributeObject{
+			Attributes: attributes,
+		}
+		switch s.Type {
+		case sdkschema.TypeList:
+			return schema.ListNestedAttribute{
+				NestedObject:        nested,
+				MarkdownDescription: s.Description,
+				Required:            required,
+				Optional:            optional,
+				Computed:            computed,
+				Sensitive:           sensitive,
+			}, err
+		case sdkschema.TypeSet:
+			return schema.SetNestedAttribute{
+				NestedObject:        nested,
+				MarkdownDescription: s.Description,
+				Required:            required,
+				Optional:            optional,
+				Computed:            computed,
+				Sensitive:           sensitive,
+			}, err
+		}
+		return nil, fmt.Errorf("unsupported SDK schema type %s with a nested resource", s.Type)
+	}
+
+	elem := sdkElemType(s.Elem)
+	switch s.Type {
+	case sdkschema.TypeList:
+		return schema.ListAttribute{
+			ElementType:         elem,
+			MarkdownDescription: s.Description,
+			Required:            required,
+			Optional:            optional,
+			Computed:            computed,
+			Sensitive:           sensitive,
+		}, nil
+	case sdkschema.TypeSet:
+		return schema.SetAttribute{
+			ElementType:         elem,
+			MarkdownDescription: s.Description,
+			Required:            required,
+			Optional:            optional,
+			Computed:            computed,
+			Sensitive:           sensitive,
+		}, nil
+	case sdkschema.TypeMap:
+		return schema.MapAttribute{
+			ElementType:         elem,
+			MarkdownDescription: s.Description,
+			Required:            required,
+			Optional:            optional,
+			Computed:            computed,
+			Sensitive:           sensitive,
+		}, nil
+	}
+	return nil, fmt.Errorf("unsupported SDK schema type %s", s.Type)
+}
+
+// sdkElemType returns the element type of SDK list, set or map of primitives.
+// The SDK map without Elem is a map of strings.
+func sdkElemType(elem any) attr.Type {
+	s, ok := elem.(*sdkschema.Schema)
+	if !ok {
+		return types.StringType
+	}
+
+	switch s.Type {
+	case sdkschema.TypeInt:
+		return types.Int64Type
+	case sdkschema.TypeFloat:
+		return types.Float64Type
+	case sdkschema.TypeBool:
+		return types.BoolType
+	}
+	return types.StringType
+}
+
+// sdkSchemaProperties converts the SDK schema to the internal Schema properties.
+// Returns the properties of the supported fields and the errors of the others.
+func sdkSchemaProperties(s map[string]*sdkschema.Schema) (map[string]*Schema, error) {
+	result := make(map[string]*Schema, len(s))
+	var errs []error
+	for k, v := range s {
+		p, err := sdkSchemaProperty(v)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("field %q: %w", k, err))
+		}
+		if p != nil {
+			result[k] = p
+		}
+	}
+	return result, errors.Join(errs...)
+}
+
+func sdkSchemaProperty(s *sdkschema.Schema) (*Schema, error) {
+	result := &Schema{
+		Computed: s.Computed,
+		IsObject: s.Type == sdkschema.TypeList && s.MaxItems == 1,
+	}
+
+	switch s.Type {
+	case sdkschema.TypeString:
+		result.Type = SchemaTypeString
+	case sdkschema.TypeInt:
+		result.Type = SchemaTypeInt
+	case sdkschema.TypeFloat:
+		result.Type = SchemaTypeFloat
+	case sdkschema.TypeBool:
+		result.Type = SchemaTypeBool
+	case sdkschema.TypeList:
+		result.Type = SchemaTypeList
+	case sdkschema.TypeSet:
+		result.Type = SchemaTypeSet
+	case sdkschema.TypeMap:
+		result.Type = SchemaTypeMap
+	default:
+		return nil, fmt.Errorf("unsupported SDK schema type %s", s.Type)
+	}
+
+	if result.Type.IsPrimitive() {
+		return result, nil
+	}
+
+	var err error
+	switch elem := s.Elem.(type) {
+	case *sdkschema.Resource:
+		result.Items = &Schema{
+			Type:     SchemaTypeObject,
+			Computed: s.Computed,
+		}
+		result.Items.Properties, err = sdkSchemaProperties(elem.Schema)
+	case *sdkschema.Schema:
+		result.Items, err = sdkSchemaProperty(elem)
+		if result.Items == nil {
+			return nil, err
+		}
+	default:
+		result.Items = &Schema{Type: SchemaTypeString}
+	}
+	return result, err
+}
+
+// fromSDKDiagnostics returns the SDK errors as an error and adds the SDK warnings to the context.
+func fromSDKDiagnostics(ctx context.Context, diags diag.Diagnostics) error {
+	var errs []error
+	for _, d := range diags {
+		if d.Severity == diag.Warning {
+			AddWarning(ctx, d.Summary, d.Detail)
+			continue
+		}
+
+		msg := d.Summary
+		if d.Detail != "" {
+			msg += ": " + d.Detail
+		}
+		errs = append(errs, errors.New(msg))
+	}
+	return errors.Join(errs...)
+}
+
+// NewSDKResourceData returns schemautil.ResourceData that reads and writes ResourceData,
+// so the SDK resource functions that take schemautil.ResourceData can be used with the plugin framework.
+// Only reading the resource is supported: there is no raw config, for instance, for the write-only fields.
+func NewSDKResourceData(d ResourceData) schemautil.ResourceData {
+	return &sdkResourceData{d: d}
+}
+
+type sdkResourceData struct {
+	d ResourceData
+
+	// err is the SetId error: schemautil.ResourceData.SetId returns nothing, like the SDK does
+	err error
+}
+
+var _ schemautil.ResourceData = (*sdkResourceData)(nil)
+
+func (s *sdkResourceData) Id() string {
+	return s.d.ID()
+}
+
+func (s *sdkResourceData) IsNewResource() bool {
+	return s.d.IsNewResource()
+}
+
+// SetId the SDK removes the resource from the state when the ID is empty.
+func (s *sdkResourceData) SetId(id string) {
+	var v any
+	if id != "" {
+		v = id
+	}
+	if err := s.d.Set(idField, v); err != nil {
+		s.err = fmt.Errorf("failed to set id: %w", err)
+	}
+}
+
+// Set converts the SDK sets to lists.
+func (s *sdkResourceData) Set(key string, value any) error {
+	return s.d.Set(key, fromSDKValue(value))
+}
+
+// Get returns the zero value of the field type when the value is not set, like the SDK does.
+func (s *sdkResourceData) Get(key string) any {
+	v, _ := s.getOk(key)
+	return v
+}
+
+// GetOk reports whether the value is set and not zero, like the SDK does.
+func (s *sdkResourceData) GetOk(key string) (any, bool) {
+	v, ok := s.getOk(key)
+	return v, ok && !isZero(v)
+}
+
+func isZero(v any) bool {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Map:
+		return rv.Len() == 0
+	}
+	return rv.IsZero()
+}
+
+func (s *sdkResourceData) getOk(key string) (any, bool) {
+	sch := sdkSchemaAt(s.d.Schema(), key)
+	if sch == nil {
+		// The SDK returns nil for unknown fields
+		return nil, false
+	}
+
+	v, ok := s.d.GetOk(key)
+	if v == nil {
+		return zeroValue(sch.Type), false
+	}
+	return v, ok
+}
+
+// GetRawConfig the raw config is not available.
+func (s *sdkResourceData) GetRawConfig() cty.Value {
+	return cty.NullVal(cty.DynamicPseudoType)
+}
+
+func (s *sdkResourceData) HasChange(key string) bool {
+	return s.d.HasChange(key)
+}
+
+// Timeout the operation timeout is set in the context.
+func (s *sdkResourceData) Timeout(string) time.Duration {
+	return schemautil.GetDefaultTimeout()
+}
+
+// sdkSchemaAt returns the schema of the field by the SDK path, for instance, "foo.0.bar".
+// Returns nil if the field is not found.
+func sdkSchemaAt(sch *Schema, key string) *Schema {
+	for part := range strings.SplitSeq(key, ".") {
+		if sch == nil {
+			return nil
+		}
+
+		switch sch.Type {
+		case SchemaTypeObject:
+			sch = sch.Properties[part]
+		case SchemaTypeList, SchemaTypeMap:
+			sch = sch.Items
+		default:
+			return nil
+		}
+	}
+	return sch
+}
+
+// fromSDKValue replaces the SDK sets with lists, including the nested ones.
+func fromSDKValue(value any) any {
+	switch v := value.(type) {
+	case *sdkschema.Set:
+		return fromSDKValue(v.List())
+	case []any:
+		result := make([]any, len(v))
+		for i, item := range v {
+			result[i] = fromSDKValue(item)
+		}
+		return result
+	case []map[string]any:
+		result := make([]any, len(v))
+		for i, item := range v {
+			result[i] = fromSDKValue(item)
+		}
+		return result
+	case map[string]any:
+		result := make(map[string]any, len(v))
+		for k, item := range v {
+			result[k] = fromSDKValue(item)
+		}
+		return result
+	}
+	return value
+}
@@ -0,0 +1,168 @@
+package adapter
+
+import (
+	"context"
+	"testing"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
+)
+
+func testSDKDataSource() *sdkschema.Resource {
+	tag := &sdkschema.Resource{
+		Schema: map[string]*sdkschema.Schema{
+			"key":   {Type: sdkschema.TypeString, Required: true},
+			"value": {Type: sdkschema.TypeString, Required: true},
+		},
+	}
+	return &sdkschema.Resource{
+		Description: "Gets information about a service.",
+		Schema: map[string]*sdkschema.Schema{
+			"project":      {Type: sdkschema.TypeString, Required: true},
+			"service_name": {Type: sdkschema.TypeString, Required: true},
+			"plan":         {Type: sdkschema.TypeString, Computed: true},
+			"service_port": {Type: sdkschema.TypeInt, Computed: true},
+			"powered":      {Type: sdkschema.TypeBool, Computed: true},
+			"static_ips": {
+				Type:     sdkschema.TypeSet,
+				Computed: true,
+				Elem:     &sdkschema.Schema{Type: sdkschema.TypeString},
+			},
+			"tag": {Type: sdkschema.TypeSet, Computed: true, Elem: tag},
+			"pg": {
+				Type:      sdkschema.TypeList,
+				Computed:  true,
+				Sensitive: true,
+				Elem: &sdkschema.Resource{
+					Schema: map[string]*sdkschema.Schema{
+						"uri":  {Type: sdkschema.TypeString, Optional: true},
+						"port": {Type: sdkschema.TypeInt, Optional: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestSDKDataSourceOptionsSchema(t *testing.T) {
+	ctx := context.Background()
+	opts := SDKDataSourceOptions("aiven_foo", testSDKDataSource(), nil)
+
+	s := opts.Schema(ctx)
+	require.False(t, s.ValidateImplementation(ctx).HasError())
+	assert.Equal(t, "Gets information about a service.", s.MarkdownDescription)
+	assert.True(t, s.Attributes["project"].IsRequired())
+	assert.True(t, s.Attributes["plan"].IsComputed())
+	assert.True(t, s.Attributes["id"].IsComputed())
+
+	// The nested fields of a computed block are computed, and of a sensitive block are sensitive
+	pg, ok := s.Attributes["pg"].(schema.ListNestedAttribute)
+	require.True(t, ok)
+	assert.True(t, pg.Sensitive)
+	uri := pg.NestedObject.Attributes["uri"]
+	assert.True(t, uri.IsComputed())
+	assert.False(t, uri.IsOptional())
+	assert.True(t, uri.IsSensitive())
+
+	_, ok = s.Attributes["tag"].(schema.SetNestedAttribute)
+	assert.True(t, ok)
+
+	// The internal schema has the same type
+	d, err := NewResourceData(opts.SchemaInternal, opts.IDFields, WithIsDataSource(), WithTestConfig(map[string]any{}))
+	require.NoError(t, err)
+	assert.True(t, s.Type().TerraformType(ctx).Equal(d.tfValue().Type()))
+}
+
+func TestSDKDataSourceOptionsRead(t *testing.T) {
+	ctx := context.Background()
+	r := testSDKDataSource()
+	read := func(_ context.Context, _ avngen.Client, d schemautil.ResourceData) diag.Diagnostics {
+		// The SDK returns the zero values for the fields that are not set and nil for the unknown ones
+		assert.Empty(t, d.Get("plan"))
+		assert.Nil(t, d.Get("user_config_json"))
+		_, ok := d.GetOk("user_config_json")
+		assert.False(t, ok)
+
+		d.SetId(d.Get("project").(string) + "/" + d.Get("service_name").(string))
+		require.NoError(t, d.Set("plan", "startup-4"))
+		require.NoError(t, d.Set("service_port", int64(5432)))
+		require.NoError(t, d.Set("powered", false))
+		require.NoError(t, d.Set("static_ips", sdkschema.NewSet(sdkschema.HashString, []any{"ip1"})))
+		require.NoError(t, d.Set("tag", sdkschema.NewSet(sdkschema.HashResource(r.Schema["tag"].Elem.(*sdkschema.Resource)), []any{
+			map[string]any{"key": "k", "value": "v"},
+		})))
+		require.NoError(t, d.Set("pg", []map[string]any{{"uri": "postgres://", "port": 5432}}))
+
+		// GetOk reports the zero values as not set, like the SDK does
+		_, ok = d.GetOk("powered")
+		assert.False(t, ok)
+		v, ok := d.GetOk("service_port")
+		assert.True(t, ok)
+		assert.Equal(t, 5432, v)
+
+		return diag.Diagnostics{{Severity: diag.Warning, Summary: "end of life"}}
+	}
+
+	opts := SDKDataSourceOptions("aiven_foo", r, read)
+	d, err := NewResourceData(opts.SchemaInternal, opts.IDFields, WithIsDataSource(), WithTestConfig(map[string]any{
+		"project":      "foo",
+		"service_name": "bar",
+	}))
+	require.NoError(t, err)
+
+	var diags fwdiag.Diagnostics
+	ctx, drain := withWarnings(ctx, &diags)
+	require.NoError(t, opts.Read(ctx, nil, d))
+	drain()
+	require.Len(t, diags, 1)
+	assert.Equal(t, "end of life", diags[0].Summary())
+
+	assert.Equal(t, "foo/bar", d.ID())
+	assert.Equal(t, []any{"ip1"}, d.Get("static_ips"))
+	assert.Equal(t, []any{map[string]any{"key": "k", "value": "v"}}, d.Get("tag"))
+	assert.Equal(t, "postgres://", d.Get("pg.0.uri"))
+	assert.NotPanics(t, func() { d.tfValue() })
+}
+
+func TestSDKDataSourceOptionsReadNotFound(t *testing.T) {
+	read := func(_ context.Context, _ avngen.Client, d schemautil.ResourceData) diag.Diagnostics {
+		d.SetId("foo/bar")
+		d.SetId("")
+		return diag.Errorf("service %q not found", "bar")
+	}
+
+	opts := SDKDataSourceOptions("aiven_foo", testSDKDataSource(), read)
+	d, err := NewResourceData(opts.SchemaInternal, opts.IDFields, WithIsDataSource(), WithTestConfig(map[string]any{}))
+	require.NoError(t, err)
+
+	err = opts.Read(context.Background(), nil, d)
+	require.EqualError(t, err, `service "bar" not found`)
+	assert.Empty(t, d.ID())
+}
+
+func TestSDKDataSourceOptionsUnsupportedType(t *testing.T) {
+	r := testSDKDataSource()
+	r.Schema["invalid"] = &sdkschema.Schema{Type: sdkschema.TypeInvalid, Computed: true}
+
+	var opts DataSourceOptions
+	require.NotPanics(t, func() {
+		opts = SDKDataSourceOptions("aiven_foo", r, nil)
+	})
+
+	// The unsupported fields are skipped
+	s := opts.Schema(context.Background())
+	assert.NotContains(t, s.Attributes, "invalid")
+	assert.Contains(t, s.Attributes, "project")
+	assert.NotContains(t, opts.SchemaInternal.Properties, "invalid")
+
+	const expected = `invalid aiven_foo schema: field "invalid": unsupported SDK schema type TypeInvalid`
+	require.ErrorContains(t, opts.ValidateConfig(context.Background(), nil, nil), expected)
+	require.ErrorContains(t, opts.Read(context.Background(), nil, nil), expected)
+}
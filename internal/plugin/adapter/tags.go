@@ -0,0 +1,141 @@
+package adapter
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/aiven/terraform-provider-aiven/internal/common"
+)
+
+// ExpandDefaultTags adds the provider default_tags to the tags of the request, the resource tags win.
+// The tags are either a list of key-value objects or a map, for instance, after the "tag" -> "tags" rename.
+// Unlike the SDK resources, the tag blocks can't be changed in the plan, so the default tags are added on apply.
+// A changed or removed default tag shows up as a diff, see FlattenDefaultTags,
+// and a new default tag marks the resource for update, see ModifyPlanDefaultTags.
+func ExpandDefaultTags(key string) MapModifier {
+	return func(_ ResourceData, dto map[string]any) error {
+		defaults := common.DefaultTags()
+		switch v := dto[key].(type) {
+		case map[string]any:
+			for _, k := range slices.Sorted(maps.Keys(defaults)) {
+				if _, ok := v[k]; !ok {
+					v[k] = defaults[k]
+				}
+			}
+		default:
+			list, _ := v.([]any)
+			tags := tagListMap(list)
+			for _, k := range slices.Sorted(maps.Keys(defaults)) {
+				if _, ok := tags[k]; !ok {
+					list = append(list, map[string]any{"key": k, "value": defaults[k]})
+				}
+			}
+			if len(list) > 0 {
+				dto[key] = list
+			}
+		}
+		return nil
+	}
+}
+
+// FlattenDefaultTags removes the provider default_tags from the list of key-value objects read from the API,
+// unless the resource sets them too. Otherwise, each plan would show the default tags as removed.
+// A tag that has an old value of a default tag or isn't a default tag anymore is kept,
+// so the plan shows an update that applies the current default tags.
+// The data sources keep all the tags.
+func FlattenDefaultTags(key string) MapModifier {
+	return func(d ResourceData, dto map[string]any) error {
+		list, ok := dto[key].([]any)
+		if !ok || d.IsDataSource() {
+			return nil
+		}
+
+		known, _ := d.Get(key).([]any)
+		tags := common.WithoutDefaultTags(tagListMap(list), tagListMap(known))
+		dto[key] = slices.DeleteFunc(list, func(item any) bool {
+			kv, _ := item.(map[string]any)
+			k, _ := kv["key"].(string)
+			_, ok := tags[k]
+			return !ok
+		})
+		return nil
+	}
+}
+
+// ModifyPlanDefaultTags marks the resource for update when the resource tags merged with the provider default_tags
+// differ from the tags of the API, for instance, when a default tag is added.
+// The tag blocks can't differ from the config in the plan, so the ID is planned unknown,
+// and the update sets it again, see resourceAdapter.Update.
+// The API tags are either a list of key-value objects or a map, they are fetched only when there are default tags.
+func ModifyPlanDefaultTags(d ResourceData, key string, apiTags func() (any, error)) error {
+	defaults := common.DefaultTags()
+	if len(defaults) == 0 || d.IsNewResource() || d.HasChange(key) {
+		// Removed default tags are kept in the state by FlattenDefaultTags
+		return nil
+	}
+
+	v, err := apiTags()
+	if err != nil {
+		return fmt.Errorf("failed to get the tags: %w", err)
+	}
+
+	remote, err := tagsMap(v)
+	if err != nil {
+		return err
+	}
+
+	list, _ := d.Get(key).([]any)
+	if maps.Equal(common.MergeDefaultTags(tagListMap(list)), remote) {
+		return nil
+	}
+
+	rd, ok := d.(*resourceData)
+	if !ok {
+		return fmt.Errorf("unexpected ResourceData type %T", d)
+	}
+	rd.plan[idField] = tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+	return nil
+}
+
+// tagsMap returns the API tags, a list of key-value objects or a map, as a map.
+func tagsMap(v any) (map[string]string, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]string)
+	if json.Unmarshal(b, &result) == nil {
+		return result, nil
+	}
+
+	var list []struct {
+		Key   string `json:"key"`
+		Value string `json:"value"`
+	}
+	if err := json.Unmarshal(b, &list); err != nil {
+		return nil, fmt.Errorf("unexpected tags type %T: %w", v, err)
+	}
+	for _, kv := range list {
+		result[kv.Key] = kv.Value
+	}
+	return result, nil
+}
+
+// tagListMap returns the key-value objects as a map.
+func tagListMap(list []any) map[string]string {
+	result := make(map[string]string, len(list))
+	for _, item := range list {
+		kv, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+		k, _ := kv["key"].(string)
+		result[k], _ = kv["value"].(string)
+	}
+	return result
+}
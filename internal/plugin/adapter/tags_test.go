@@ -0,0 +1,244 @@
+package adapter
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aiven/terraform-provider-aiven/internal/common"
+)
+
+func TestDefaultTagsModifiers(t *testing.T) {
+	common.SetDefaultTags(map[string]string{"owner": "team", "env": "dev"})
+	t.Cleanup(func() { common.SetDefaultTags(nil) })
+
+	sch := &Schema{
+		Type: SchemaTypeObject,
+		Properties: map[string]*Schema{
+			"name": {Type: SchemaTypeString},
+			"tag": {
+				Type: SchemaTypeSet,
+				Items: &Schema{
+					Type: SchemaTypeObject,
+					Properties: map[string]*Schema{
+						"key":   {Type: SchemaTypeString},
+						"value": {Type: SchemaTypeString},
+					},
+				},
+			},
+		},
+	}
+	tags := []any{
+		map[string]any{"key": "env", "value": "prod"},
+	}
+
+	t.Run("expand adds the default tags", func(t *testing.T) {
+		rd, err := NewResourceData(sch, []string{"name"},
+			WithTestPlan(map[string]any{"name": "foo", "tag": tags}),
+		)
+		require.NoError(t, err)
+
+		var out map[string]any
+		require.NoError(t, rd.Expand(&out, RenameFields(map[string]string{"tag": "tags"}), ExpandDefaultTags("tags")))
+		expected := []any{
+			map[string]any{"key": "env", "value": "prod"},
+			map[string]any{"key": "owner", "value": "team"},
+		}
+		assert.Equal(t, expected, out["tags"])
+	})
+
+	t.Run("flatten removes the default tags", func(t *testing.T) {
+		rd, err := NewResourceData(sch, []string{"name"},
+			WithTestState(map[string]any{"name": "foo", "tag": tags}),
+		)
+		require.NoError(t, err)
+
+		in := map[string]any{
+			"name": "foo",
+			"tags": []any{
+				map[string]any{"key": "env", "value": "prod"},
+				map[string]any{"key": "owner", "value": "team"},
+			},
+		}
+		require.NoError(t, rd.Flatten(in, RenameFields(map[string]string{"tags": "tag"}), FlattenDefaultTags("tag")))
+		assert.Equal(t, tags, rd.Get("tag"))
+	})
+
+	t.Run("changing default tags", func(t *testing.T) {
+		// The API has the tags applied with the previous default tags
+		in := map[string]any{
+			"name": "foo",
+			"tags": []any{
+				map[string]any{"key": "env", "value": "prod"},
+				map[string]any{"key": "owner", "value": "team"},
+				map[string]any{"key": "removed", "value": "old"},
+			},
+		}
+		common.SetDefaultTags(map[string]string{"owner": "platform", "added": "new"})
+		t.Cleanup(func() { common.SetDefaultTags(map[string]string{"owner": "team", "env": "dev"}) })
+
+		// The changed and the removed default tags stay in the state, so the plan shows an update
+		rd, err := NewResourceData(sch, []string{"name"},
+			WithTestState(map[string]any{"name": "foo", "tag": tags}),
+		)
+		require.NoError(t, err)
+		require.NoError(t, rd.Flatten(in, RenameFields(map[string]string{"tags": "tag"}), FlattenDefaultTags("tag")))
+		assert.Equal(t, in["tags"], rd.Get("tag"))
+
+		// The update applies the current default tags, the new one included
+		rd, err = NewResourceData(sch, []string{"name"},
+			WithTestPlan(map[string]any{"name": "foo", "tag": tags}),
+		)
+		require.NoError(t, err)
+
+		var out map[string]any
+		require.NoError(t, rd.Expand(&out, RenameFields(map[string]string{"tag": "tags"}), ExpandDefaultTags("tags")))
+		expected := []any{
+			map[string]any{"key": "env", "value": "prod"},
+			map[string]any{"key": "added", "value": "new"},
+			map[string]any{"key": "owner", "value": "platform"},
+		}
+		assert.Equal(t, expected, out["tags"])
+
+		// A new default tag alone doesn't change the state, the plan marks the resource for update, see ModifyPlanDefaultTags
+		in = map[string]any{"name": "foo", "tags": out["tags"]}
+		common.SetDefaultTags(map[string]string{"owner": "platform", "added": "new", "team": "data"})
+		rd, err = NewResourceData(sch, []string{"name"},
+			WithTestState(map[string]any{"name": "foo", "tag": tags}),
+		)
+		require.NoError(t, err)
+		require.NoError(t, rd.Flatten(in, RenameFields(map[string]string{"tags": "tag"}), FlattenDefaultTags("tag")))
+		assert.Equal(t, tags, rd.Get("tag"))
+	})
+
+	t.Run("data source keeps the default tags", func(t *testing.T) {
+		rd, err := NewResourceData(sch, []string{"name"},
+			WithIsDataSource(),
+			WithTestConfig(map[string]any{"name": "foo"}),
+		)
+		require.NoError(t, err)
+
+		in := map[string]any{
+			"name": "foo",
+			"tags": []any{
+				map[string]any{"key": "owner", "value": "team"},
+			},
+		}
+		require.NoError(t, rd.Flatten(in, RenameFields(map[string]string{"tags": "tag"}), FlattenDefaultTags("tag")))
+		assert.Len(t, rd.Get("tag"), 1)
+	})
+}
+
+func TestModifyPlanDefaultTags(t *testing.T) {
+	sch := &Schema{
+		Type: SchemaTypeObject,
+		Properties: map[string]*Schema{
+			"id":   {Type: SchemaTypeString, Computed: true},
+			"name": {Type: SchemaTypeString},
+			"tag": {
+				Type: SchemaTypeSet,
+				Items: &Schema{
+					Type: SchemaTypeObject,
+					Properties: map[string]*Schema{
+						"key":   {Type: SchemaTypeString},
+						"value": {Type: SchemaTypeString},
+					},
+				},
+			},
+		},
+	}
+	tags := []any{
+		map[string]any{"key": "env", "value": "prod"},
+	}
+
+	cases := []struct {
+		name          string
+		defaultTags   map[string]string
+		planTags      []any
+		apiTags       any
+		expectFetch   bool
+		expectUpdate  bool
+		expectedError string
+	}{
+		{
+			name:     "no default tags",
+			planTags: tags,
+		},
+		{
+			name:        "tags changed",
+			defaultTags: map[string]string{"owner": "team"},
+			planTags:    []any{map[string]any{"key": "env", "value": "dev"}},
+		},
+		{
+			name:        "default tags applied",
+			defaultTags: map[string]string{"owner": "team"},
+			planTags:    tags,
+			apiTags:     map[string]string{"env": "prod", "owner": "team"},
+			expectFetch: true,
+		},
+		{
+			name:         "new default tag",
+			defaultTags:  map[string]string{"owner": "team", "team": "data"},
+			planTags:     tags,
+			apiTags:      map[string]string{"env": "prod", "owner": "team"},
+			expectFetch:  true,
+			expectUpdate: true,
+		},
+		{
+			name:        "list of key-value objects",
+			defaultTags: map[string]string{"owner": "team"},
+			planTags:    tags,
+			apiTags: []struct {
+				Key   string `json:"key"`
+				Value string `json:"value"`
+			}{{Key: "env", Value: "prod"}, {Key: "owner", Value: "team"}},
+			expectFetch: true,
+		},
+		{
+			name:          "api error",
+			defaultTags:   map[string]string{"owner": "team"},
+			planTags:      tags,
+			expectFetch:   true,
+			expectedError: "failed to get the tags: 503",
+		},
+	}
+
+	for _, opt := range cases {
+		t.Run(opt.name, func(t *testing.T) {
+			common.SetDefaultTags(opt.defaultTags)
+			t.Cleanup(func() { common.SetDefaultTags(nil) })
+
+			rd, err := NewResourceData(sch, []string{"name"},
+				WithTestState(map[string]any{"id": "foo", "name": "foo", "tag": tags}),
+				WithTestPlan(map[string]any{"id": "foo", "name": "foo", "tag": opt.planTags}),
+			)
+			require.NoError(t, err)
+
+			fetched := false
+			err = ModifyPlanDefaultTags(rd, "tag", func() (any, error) {
+				fetched = true
+				if opt.apiTags == nil {
+					return nil, errors.New("503")
+				}
+				return opt.apiTags, nil
+			})
+			if opt.expectedError != "" {
+				require.EqualError(t, err, opt.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, opt.expectFetch, fetched)
+
+			id := rd.(*resourceData).plan["id"]
+			if opt.expectUpdate {
+				assert.Equal(t, tftypes.NewValue(tftypes.String, tftypes.UnknownValue), id)
+			} else {
+				assert.Equal(t, "foo", id)
+			}
+		})
+	}
+}
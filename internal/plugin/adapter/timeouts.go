@@ -19,6 +19,7 @@ const (
 	timeoutRead    timeoutType = "read"
 	timeoutUpdate  timeoutType = "update"
 	timeoutDelete  timeoutType = "delete"
+	timeoutOpen    timeoutType = "open"
 	timeoutDefault timeoutType = "default"
 )
 
@@ -51,6 +52,12 @@ func getTimeoutValue(
 	timeoutKey timeoutType,
 	fallback time.Duration,
 ) (time.Duration, error) {
+	// The data sources that keep the SDK schema have no timeouts block, see SDKDataSourceOptions.
+	if schema := d.Schema(); schema != nil && schema.Properties["timeouts"] == nil {
+		tflog.Info(ctx, fmt.Sprintf("Using fallback timeout for %q: %s", timeoutKey, fallback))
+		return fallback, nil
+	}
+
 	// Note: the timeouts block is not represented as a list of objects, hence the index is not used.
 	v, ok := d.GetOk(fmt.Sprintf("timeouts.%s", timeoutKey))
 	if ok {
@@ -0,0 +1,229 @@
+// Package batchrepository batches and caches reads of the entities that the API lists per service,
+// for instance, Kafka ACLs and service users.
+// Refreshing thousands of such resources would call a GET endpoint thousands of times.
+// Instead, the reads are queued and the worker calls the service list endpoint once per service,
+// then serves the rest of the reads from its result.
+// See kafkatopicrepository for the same approach applied to Kafka topics.
+//
+// The generated resources can't use it: the generator emits one GET call per resource.
+// So the resources that read through it keep the create operations in their definitions,
+// and the read and delete views are hand-written.
+package batchrepository
+
+import (
+	"context"
+	"maps"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	avngen "github.com/aiven/go-client-codegen"
+)
+
+// defaultWorkerCallInterval how often worker should run
+const defaultWorkerCallInterval = time.Second
+
+// ListFunc returns all entities of the service by their keys, e.g., ACLs by ID.
+type ListFunc[T any] func(ctx context.Context, client avngen.Client, project, service string) (map[string]*T, error)
+
+// Index returns the list elements by their keys, see ListFunc.
+func Index[T any](list []T, key func(i int) string) map[string]*T {
+	result := make(map[string]*T, len(list))
+	for i := range list {
+		result[key(i)] = &list[i]
+	}
+	return result
+}
+
+// Repository reads the entities of the given type.
+// Every resource type must use a single instance, so parallel reads share the same queue and cache.
+type Repository[T any] struct {
+	sync.Mutex
+	name               string
+	list               ListFunc[T]
+	queue              []*request[T]
+	workerOnce         sync.Once
+	workerCallInterval time.Duration
+
+	// seen stores the results of the list calls by the service key.
+	// Each entity is served once, so the following reads of the same entity go to the API.
+	// That also keeps RefreshState polling working after create and update.
+	seen map[string]map[string]*T
+
+	// versions counts Forget calls by the service key,
+	// so a list call that runs in parallel with a change doesn't cache the outdated result
+	versions map[string]int
+}
+
+// New returns a Repository. The name is used in the "not found" errors.
+func New[T any](name string, list ListFunc[T]) *Repository[T] {
+	return &Repository[T]{
+		name:               name,
+		list:               list,
+		workerCallInterval: defaultWorkerCallInterval,
+		seen:               make(map[string]map[string]*T),
+		versions:           make(map[string]int),
+	}
+}
+
+// Read returns the entity by the key or a "not found" error.
+// Reads the cache first, otherwise adds the request to the queue and waits for the worker.
+func (rep *Repository[T]) Read(ctx context.Context, client avngen.Client, project, service, key string) (*T, error) {
+	serviceKey := newKey(project, service)
+	rep.Lock()
+	if v, ok := rep.seen[serviceKey][key]; ok {
+		delete(rep.seen[serviceKey], key)
+		rep.Unlock()
+		return v, nil
+	}
+
+	// The entity might be missing from the cache because it has been created after the list call.
+	// Goes to the API to be sure.
+	c := make(chan *response[T], 1)
+	rep.queue = append(rep.queue, &request[T]{
+		client:  client,
+		project: project,
+		service: service,
+		key:     key,
+		rsp:     c,
+	})
+	rep.Unlock()
+
+	rep.workerOnce.Do(func() {
+		go rep.worker()
+	})
+
+	// Waits response from the channel
+	// Or exits on context done
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case rsp := <-c:
+		return rsp.value, rsp.err
+	}
+}
+
+// List returns all entities of the service and caches them,
+// so the following Read calls don't call the API.
+func (rep *Repository[T]) List(ctx context.Context, client avngen.Client, project, service string) (map[string]*T, error) {
+	serviceKey := newKey(project, service)
+	version := rep.version(serviceKey)
+	list, err := rep.list(ctx, client, project, service)
+	if err != nil {
+		return nil, err
+	}
+
+	rep.store(serviceKey, version, maps.Clone(list))
+	return list, nil
+}
+
+// Forget removes the cache of the service.
+// Must be called after the entities of the service are changed, e.g., on create, update or delete.
+func (rep *Repository[T]) Forget(project, service string) {
+	rep.Lock()
+	defer rep.Unlock()
+	serviceKey := newKey(project, service)
+	delete(rep.seen, serviceKey)
+	rep.versions[serviceKey]++
+}
+
+func (rep *Repository[T]) version(serviceKey string) int {
+	rep.Lock()
+	defer rep.Unlock()
+	return rep.versions[serviceKey]
+}
+
+// store caches the list result, unless the service has been changed since the list call
+func (rep *Repository[T]) store(serviceKey string, version int, seen map[string]*T) {
+	rep.Lock()
+	defer rep.Unlock()
+	if rep.versions[serviceKey] == version {
+		rep.seen[serviceKey] = seen
+	}
+}
+
+// worker processes the queue with fetch and ticker (rate-limit). Runs in the background.
+func (rep *Repository[T]) worker() {
+	ticker := time.NewTicker(rep.workerCallInterval)
+	for {
+		<-ticker.C
+		q := rep.withdraw()
+		if q != nil {
+			rep.fetch(context.Background(), q)
+		}
+	}
+}
+
+// withdraw returns the queue grouped by service and cleans it
+func (rep *Repository[T]) withdraw() map[string][]*request[T] {
+	rep.Lock()
+	defer rep.Unlock()
+
+	if len(rep.queue) == 0 {
+		return nil
+	}
+
+	q := make(map[string][]*request[T])
+	for _, r := range rep.queue {
+		k := newKey(r.project, r.service)
+		q[k] = append(q[k], r)
+	}
+
+	rep.queue = make([]*request[T], 0)
+	return q
+}
+
+// fetch calls the list once per service and sends the results.
+// The entities that were not requested are cached for the following reads.
+func (rep *Repository[T]) fetch(ctx context.Context, queue map[string][]*request[T]) {
+	for serviceKey, reqs := range queue {
+		// Requests are grouped by service, we can share these values
+		project := reqs[0].project
+		service := reqs[0].service
+		version := rep.version(serviceKey)
+		list, err := rep.list(ctx, reqs[0].client, project, service)
+		if err != nil {
+			for _, r := range reqs {
+				r.send(nil, err)
+			}
+			continue
+		}
+
+		seen := maps.Clone(list)
+		for _, r := range reqs {
+			v, ok := list[r.key]
+			if !ok {
+				r.send(nil, avngen.Error{Status: http.StatusNotFound, Message: rep.name + " not found"})
+				continue
+			}
+
+			delete(seen, r.key)
+			r.send(v, nil)
+		}
+
+		rep.store(serviceKey, version, seen)
+	}
+}
+
+type response[T any] struct {
+	value *T
+	err   error
+}
+
+type request[T any] struct {
+	client  avngen.Client
+	project string
+	service string
+	key     string
+	rsp     chan *response[T]
+}
+
+func (r *request[T]) send(v *T, err error) {
+	r.rsp <- &response[T]{value: v, err: err}
+}
+
+// newKey build path-like "key" from given strings.
+func newKey(parts ...string) string {
+	return strings.Join(parts, "/")
+}
@@ -0,0 +1,210 @@
+package batchrepository
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type item struct {
+	ID string
+}
+
+// fakeList emulates the list endpoint, the storage keys are "project/service"
+type fakeList struct {
+	storage map[string][]string
+	err     error
+	called  atomic.Int32
+}
+
+func (f *fakeList) list(_ context.Context, _ avngen.Client, project, service string) (map[string]*item, error) {
+	f.called.Add(1)
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	ids, ok := f.storage[newKey(project, service)]
+	if !ok {
+		return nil, avngen.Error{Status: 404, Message: "Service not found"}
+	}
+
+	list := make([]item, len(ids))
+	for i, id := range ids {
+		list[i] = item{ID: id}
+	}
+	return Index(list, func(i int) string { return list[i].ID }), nil
+}
+
+func newTestRepository(f *fakeList) *Repository[item] {
+	rep := New("Item", f.list)
+	rep.workerCallInterval = time.Millisecond
+	return rep
+}
+
+// TestRepositoryRead reads in parallel, the reads are batched by service
+func TestRepositoryRead(t *testing.T) {
+	cases := []struct {
+		name     string
+		storage  map[string][]string
+		listErr  error
+		requests []string // "project/service/id"
+		found    []string
+		notFound []string
+		err      error
+		called   int32
+	}{
+		{
+			name:     "reads existing items with one call",
+			storage:  map[string][]string{"a/b": {"c", "d", "e"}},
+			requests: []string{"a/b/c", "a/b/d"},
+			found:    []string{"a/b/c", "a/b/d"},
+			called:   1,
+		},
+		{
+			name:     "unknown item returns 404",
+			storage:  map[string][]string{"a/b": {"c"}},
+			requests: []string{"a/b/c", "a/b/d"},
+			found:    []string{"a/b/c"},
+			notFound: []string{"a/b/d"},
+			called:   1,
+		},
+		{
+			name:     "one call per service",
+			storage:  map[string][]string{"a/b": {"c"}, "a/d": {"e"}},
+			requests: []string{"a/b/c", "a/d/e"},
+			found:    []string{"a/b/c", "a/d/e"},
+			called:   2,
+		},
+		{
+			name:     "unknown service returns 404",
+			storage:  map[string][]string{},
+			requests: []string{"a/b/c"},
+			notFound: []string{"a/b/c"},
+			called:   1,
+		},
+		{
+			name:     "list error is sent to all requests",
+			listErr:  errors.New("oh no"),
+			requests: []string{"a/b/c", "a/b/d"},
+			err:      errors.New("oh no"),
+			called:   1,
+		},
+	}
+
+	for _, opt := range cases {
+		t.Run(opt.name, func(t *testing.T) {
+			f := &fakeList{storage: opt.storage, err: opt.listErr}
+			rep := newTestRepository(f)
+
+			var mu sync.Mutex
+			found := make([]string, 0)
+			notFound := make([]string, 0)
+
+			// Disables the worker, so all the requests are in the same batch
+			rep.workerOnce.Do(func() {})
+			var wg sync.WaitGroup
+			for _, r := range opt.requests {
+				wg.Go(func() {
+					ctx, cancel := context.WithTimeout(t.Context(), time.Second)
+					defer cancel()
+
+					parts := strings.Split(r, "/")
+					project, service, id := parts[0], parts[1], parts[2]
+					v, err := rep.Read(ctx, nil, project, service, id)
+
+					mu.Lock()
+					defer mu.Unlock()
+					switch {
+					case opt.err != nil:
+						assert.EqualError(t, err, opt.err.Error())
+					case avngen.IsNotFound(err):
+						notFound = append(notFound, r)
+					default:
+						assert.NoError(t, err)
+						assert.Equal(t, id, v.ID)
+						found = append(found, r)
+					}
+				})
+			}
+			require.Eventually(t, func() bool {
+				rep.Lock()
+				defer rep.Unlock()
+				return len(rep.queue) == len(opt.requests)
+			}, time.Second, time.Millisecond)
+			rep.fetch(t.Context(), rep.withdraw())
+			wg.Wait()
+
+			if opt.err == nil {
+				assert.ElementsMatch(t, opt.found, found)
+				assert.ElementsMatch(t, opt.notFound, notFound)
+			}
+			assert.Equal(t, opt.called, f.called.Load())
+		})
+	}
+}
+
+// TestRepositoryCache reads from the cache once, the second read goes to the API
+func TestRepositoryCache(t *testing.T) {
+	ctx := t.Context()
+	f := &fakeList{storage: map[string][]string{"a/b": {"c", "d"}}}
+	rep := newTestRepository(f)
+
+	// Calls the list, "d" is cached
+	_, err := rep.Read(ctx, nil, "a", "b", "c")
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, f.called.Load())
+
+	v, err := rep.Read(ctx, nil, "a", "b", "d")
+	require.NoError(t, err)
+	assert.Equal(t, "d", v.ID)
+	assert.EqualValues(t, 1, f.called.Load())
+
+	// Cached entities are served once
+	_, err = rep.Read(ctx, nil, "a", "b", "d")
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, f.called.Load())
+
+	// The list caches everything
+	list, err := rep.List(ctx, nil, "a", "b")
+	require.NoError(t, err)
+	assert.Len(t, list, 2)
+	assert.EqualValues(t, 3, f.called.Load())
+
+	// Forget removes the cache: "d" has been deleted
+	f.storage["a/b"] = []string{"c"}
+	rep.Forget("a", "b")
+	_, err = rep.Read(ctx, nil, "a", "b", "d")
+	assert.True(t, avngen.IsNotFound(err))
+	assert.EqualValues(t, 4, f.called.Load())
+}
+
+func TestRepositoryContextWithDeadline(t *testing.T) {
+	ctx, cancel := context.WithDeadline(t.Context(), time.Now().Add(-time.Second))
+	defer cancel()
+
+	rep := newTestRepository(&fakeList{storage: map[string][]string{"a/b": {"c"}}})
+	v, err := rep.Read(ctx, nil, "a", "b", "c")
+	assert.Nil(t, v)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+// TestRepositoryForgetDuringList doesn't cache the list result when the service has been changed meanwhile
+func TestRepositoryForgetDuringList(t *testing.T) {
+	rep := New[item]("Item", nil)
+	rep.list = func(_ context.Context, _ avngen.Client, project, service string) (map[string]*item, error) {
+		rep.Forget(project, service)
+		return map[string]*item{"c": {ID: "c"}}, nil
+	}
+
+	_, err := rep.List(t.Context(), nil, "a", "b")
+	require.NoError(t, err)
+	assert.Empty(t, rep.seen)
+}
@@ -25,6 +25,9 @@ const (
 	// SummaryTokenMissing is the error summary for when a token is missing.
 	SummaryTokenMissing = "Token Missing"
 
+	// SummaryTokenRead is the error summary for when a token cannot be read from a file or a command.
+	SummaryTokenRead = "Token Read Failed"
+
 	// SummaryConstructingClient is the error summary for when a client cannot be constructed.
 	SummaryConstructingClient = "Constructing Client Failed"
 
@@ -63,6 +66,12 @@ const (
 
 	// SummaryInvalidConfiguration is the error summary for when a configuration is invalid.
 	SummaryInvalidConfiguration = "Invalid Configuration"
+
+	// SummaryErrorInvokingAction is the error summary for when an action cannot be invoked.
+	SummaryErrorInvokingAction = "Error Invoking Action"
+
+	// SummaryServicePoweredOff is the warning summary for when a resource of a powered off service is not refreshed.
+	SummaryServicePoweredOff = "Service Powered Off"
 )
 
 // Below is the list of detailed error messages that are used in the provider.
@@ -79,8 +88,11 @@ var (
 	DetailUnexpectedError = "An unexpected error occurred: %s."
 
 	// DetailTokenMissing is the detailed error message for when a token is missing.
-	DetailTokenMissing = "Aiven API token was not set in the provider configuration or in the AIVEN_TOKEN " +
-		"environment variable."
+	DetailTokenMissing = "Aiven API token was not set in the provider configuration or in the AIVEN_TOKEN, " +
+		"AIVEN_TOKEN_FILE or AIVEN_TOKEN_COMMAND environment variables."
+
+	// DetailTokenRead is the detailed error message for when a token cannot be read from a file or a command.
+	DetailTokenRead = "Aiven API token could not be read from the %s: %s."
 
 	// DetailUnexpectedProviderDataType is the detailed error message for when the provider data type is unexpected.
 	DetailUnexpectedProviderDataType = "Expected *aiven.Client, got: %T. Please report this issue to the " +
@@ -109,6 +121,9 @@ var (
 	DetailDuplicateFoundByName = "Multiple resources with the same name (%s) were found. Please use the ID to " +
 		"uniquely identify the resource."
 
+	// DetailErrorInvokingAction is the detailed error message for when an action cannot be invoked.
+	DetailErrorInvokingAction = "An unexpected error occurred while invoking the action (%s): %s."
+
 	// DetailErrorReadingDataSource is the detailed error message for when a data source cannot be read.
 	DetailErrorReadingDataSource = "An unexpected error occurred while reading the data source (%s): %s."
 
@@ -126,6 +141,11 @@ var (
 
 	// DetailResourceNotFound is the detailed error message for when a resource cannot be found.
 	DetailResourceNotFound = "Resource with ID %s does not exist: %s."
+
+	// DetailServicePoweredOff is the detailed warning message for when a resource of a powered off service
+	// is not refreshed.
+	DetailServicePoweredOff = "The service %s/%s is powered off, the resource (%s) is not refreshed and keeps " +
+		"its previous state."
 )
 
 // Below is the list of classic Go-style error messages that are used in the provider.
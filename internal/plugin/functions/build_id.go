@@ -0,0 +1,50 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
+)
+
+var _ function.Function = (*buildIDFunction)(nil)
+
+// buildIDFunction builds a resource ID the same way the resources do.
+type buildIDFunction struct{}
+
+func NewBuildIDFunction() function.Function {
+	return &buildIDFunction{}
+}
+
+func (f *buildIDFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "build_id"
+}
+
+func (f *buildIDFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Builds a resource ID from its parts.",
+		MarkdownDescription: "Joins the parts with `/` and escapes each part, so the result matches the `id` of the resource. " +
+			"For example, `provider::aiven::build_id(\"my-project\", \"my-pg\", \"my-user\")` returns `my-project/my-pg/my-user`.",
+		VariadicParameter: function.StringParameter{
+			Name:                "parts",
+			MarkdownDescription: "The ID parts in the order the resource expects them.",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *buildIDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var parts []string
+	resp.Error = req.Arguments.Get(ctx, &parts)
+	if resp.Error != nil {
+		return
+	}
+
+	if len(parts) == 0 {
+		resp.Error = function.NewArgumentFuncError(0, "at least one part is required")
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, schemautil.BuildResourceID(parts...))
+}
@@ -0,0 +1,15 @@
+// Package functions implements the provider functions, for instance, provider::aiven::build_id.
+package functions
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Functions returns the provider functions.
+func Functions() []func() function.Function {
+	return []func() function.Function{
+		NewBuildIDFunction,
+		NewSplitIDFunction,
+		NewParseServiceURIFunction,
+	}
+}
@@ -0,0 +1,84 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
+)
+
+var _ function.Function = (*splitIDFunction)(nil)
+
+// splitIDFunction splits a resource ID the same way the resources do.
+type splitIDFunction struct{}
+
+func NewSplitIDFunction() function.Function {
+	return &splitIDFunction{}
+}
+
+func (f *splitIDFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "split_id"
+}
+
+func (f *splitIDFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Splits a resource ID into its parts.",
+		MarkdownDescription: "Splits the ID by `/` and unescapes each part. " +
+			"For example, `provider::aiven::split_id(\"my-project/my-pg/my-user\")` returns `[\"my-project\", \"my-pg\", \"my-user\"]`. " +
+			"Use `size` to split the ID into the number of parts the resource expects, for instance, `size = 3`. " +
+			"Like in the resources, the last part keeps the rest of the ID, including slashes.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "id",
+				MarkdownDescription: "The resource ID.",
+			},
+		},
+		VariadicParameter: function.Int64Parameter{
+			Name:                "size",
+			MarkdownDescription: "The expected number of parts. Optional.",
+		},
+		Return: function.ListReturn{ElementType: types.StringType},
+	}
+}
+
+func (f *splitIDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var id string
+	var size []int64
+	resp.Error = req.Arguments.Get(ctx, &id, &size)
+	if resp.Error != nil {
+		return
+	}
+
+	if id == "" {
+		resp.Error = function.NewArgumentFuncError(0, "id must not be empty")
+		return
+	}
+
+	if len(size) > 1 {
+		resp.Error = function.NewArgumentFuncError(1, "only one size is allowed")
+		return
+	}
+
+	// Without the size, splits by every slash
+	n := strings.Count(id, "/") + 1
+	if len(size) == 1 {
+		if size[0] < 1 {
+			resp.Error = function.NewArgumentFuncError(1, "size must be at least 1")
+			return
+		}
+		n = int(size[0])
+	}
+
+	// The same split as in the resources: the last part keeps the rest of the ID
+	parts, err := schemautil.SplitResourceID(id, n)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("invalid resource id %q: expected %d parts", id, n))
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, parts)
+}
@@ -40,6 +40,27 @@ func (rep *repository) Read(ctx context.Context, project, service, topic string)
 	}
 }
 
+// List returns the topic names of the service from v1List and marks them seen,
+// so the following Read calls skip it and fetch the topics with batched V2List.
+func (rep *repository) List(ctx context.Context, project, service string) ([]string, error) {
+	list, err := rep.client.ServiceKafkaTopicList(ctx, project, service)
+	if err != nil {
+		return nil, err
+	}
+
+	rep.Lock()
+	defer rep.Unlock()
+
+	serviceKey := newKey(project, service)
+	names := make([]string, 0, len(list))
+	for _, t := range list {
+		names = append(names, t.TopicName)
+		rep.seenTopics[newKey(serviceKey, t.TopicName)] = true
+	}
+	rep.seenServices[serviceKey] = true
+	return names, nil
+}
+
 // Exists omits service not found
 func (rep *repository) Exists(ctx context.Context, project, service, topic string) (bool, error) {
 	err := rep.exists(ctx, project, service, topic, false)
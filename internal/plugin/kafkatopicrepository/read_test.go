@@ -0,0 +1,50 @@
+package kafkatopicrepository
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aiven/go-client-codegen/handler/kafkatopic"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRepositoryList lists topics and reads them in parallel.
+// v1List is called once by List, the reads are batched into a single v2List.
+func TestRepositoryList(t *testing.T) {
+	client := &fakeTopicClient{
+		storage: map[string]kafkatopic.TopicOut{
+			"a/b/c": {TopicName: "c"},
+			"a/b/d": {TopicName: "d"},
+			"a/b/e": {TopicName: "e"},
+			"a/f/g": {TopicName: "g"},
+		},
+	}
+
+	ctx := context.Background()
+	rep := newRepository(client)
+	rep.workerCallInterval = 10 * time.Millisecond
+
+	names, err := rep.List(ctx, "a", "b")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"c", "d", "e"}, names)
+
+	var wg sync.WaitGroup
+	for _, name := range names {
+		wg.Go(func() {
+			topic, err := rep.Read(ctx, "a", "b", name)
+			assert.NoError(t, err)
+			assert.Equal(t, &kafkatopic.ServiceKafkaTopicGetOut{TopicName: name}, topic)
+		})
+	}
+
+	// Lets all the reads get into the queue before the worker runs
+	time.Sleep(50 * time.Millisecond)
+	go rep.worker()
+	wg.Wait()
+
+	assert.EqualValues(t, 1, client.v1ListCalled)
+	assert.EqualValues(t, 1, client.v2ListCalled)
+}
@@ -50,6 +50,7 @@ type Repository interface {
 	Update(ctx context.Context, project, service, topic string, req *kafkatopic.ServiceKafkaTopicUpdateIn) error
 	Delete(ctx context.Context, project, service, topic string) error
 	Exists(ctx context.Context, project, service, topic string) (bool, error)
+	List(ctx context.Context, project, service string) ([]string, error)
 }
 
 // topicsClient interface for unit tests
@@ -3,24 +3,39 @@ package plugin
 
 import (
 	"context"
-	"os"
+	"fmt"
+	"maps"
+	"time"
 
 	"github.com/aiven/aiven-go-client/v2"
 	avngen "github.com/aiven/go-client-codegen"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/samber/lo"
 
 	"github.com/aiven/terraform-provider-aiven/internal/common"
+	"github.com/aiven/terraform-provider-aiven/internal/plugin/actions"
 	"github.com/aiven/terraform-provider-aiven/internal/plugin/errmsg"
+	"github.com/aiven/terraform-provider-aiven/internal/plugin/functions"
 	"github.com/aiven/terraform-provider-aiven/internal/plugin/providerdata"
 	"github.com/aiven/terraform-provider-aiven/internal/plugin/service/externalidentity"
+	"github.com/aiven/terraform-provider-aiven/internal/plugin/service/kafka/topicpartitions"
 	"github.com/aiven/terraform-provider-aiven/internal/plugin/service/organization/groupproject"
 	"github.com/aiven/terraform-provider-aiven/internal/plugin/service/organization/organization"
 	"github.com/aiven/terraform-provider-aiven/internal/plugin/service/organization/permission"
+	"github.com/aiven/terraform-provider-aiven/internal/plugin/service/servicecredentials"
+	"github.com/aiven/terraform-provider-aiven/internal/plugin/service/servicedatasource"
 	"github.com/aiven/terraform-provider-aiven/internal/plugin/util"
 )
 
@@ -34,6 +49,9 @@ type AivenProvider struct {
 
 	// GenClient is the generated Aiven client
 	GenClient avngen.Client
+
+	// sdkListResources are the list resources of the SDK provider resources, see New.
+	sdkListResources []func() list.ListResource
 }
 
 // GetClient returns the handwritten Aiven client.
@@ -47,14 +65,54 @@ func (p *AivenProvider) GetGenClient() avngen.Client {
 }
 
 var (
-	_ provider.Provider         = &AivenProvider{}
-	_ providerdata.ProviderData = &AivenProvider{}
+	_ provider.Provider                       = &AivenProvider{}
+	_ provider.ProviderWithEphemeralResources = &AivenProvider{}
+	_ provider.ProviderWithFunctions          = &AivenProvider{}
+	_ provider.ProviderWithListResources      = &AivenProvider{}
+	_ provider.ProviderWithActions            = &AivenProvider{}
+	_ providerdata.ProviderData               = &AivenProvider{}
 )
 
 // AivenProviderModel is the configuration for the Aiven provider.
 type AivenProviderModel struct {
 	// APIToken is the Aiven API token.
 	APIToken types.String `tfsdk:"api_token"`
+
+	// APITokenFile is the path to a file with the Aiven API token.
+	APITokenFile types.String `tfsdk:"api_token_file"`
+
+	// APITokenCommand is the command that prints the Aiven API token.
+	APITokenCommand types.String `tfsdk:"api_token_command"`
+
+	// APIURL is the Aiven API base URL.
+	APIURL types.String `tfsdk:"api_url"`
+
+	// MaxRetries is the maximum number of retries of a failed request.
+	MaxRetries types.Int64 `tfsdk:"max_retries"`
+
+	// RetryBackoff is the minimum wait time between retries.
+	RetryBackoff types.String `tfsdk:"retry_backoff"`
+
+	// RequestTimeout is the timeout of a single HTTP request attempt.
+	RequestTimeout types.String `tfsdk:"request_timeout"`
+
+	// HTTPProxy is the HTTP proxy URL.
+	HTTPProxy types.String `tfsdk:"http_proxy"`
+
+	// RateLimit is the client-side limit of requests per second.
+	RateLimit types.Float64 `tfsdk:"rate_limit"`
+
+	// CostWarningThreshold is the estimated monthly cost increase that shows a warning in the plan.
+	CostWarningThreshold types.Float64 `tfsdk:"cost_warning_threshold"`
+
+	// DefaultTags are the tags that are added to all taggable resources.
+	DefaultTags []DefaultTagsModel `tfsdk:"default_tags"`
+}
+
+// DefaultTagsModel is the default_tags block of the provider configuration.
+type DefaultTagsModel struct {
+	// Tags are the key-value pairs of the default tags.
+	Tags map[string]string `tfsdk:"tags"`
 }
 
 // Metadata returns information about the provider.
@@ -82,6 +140,82 @@ func (p *AivenProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp
 				Optional:  true,
 				Sensitive: true,
 			},
+			// Descriptions below should match the ones in internal/sdkprovider/provider/provider.go.
+			"api_token_file": schema.StringAttribute{
+				Description: "Path to a file with the Aiven authentication token, for instance, rotated by a secret agent. " +
+					"The file is read again when the API rejects the token. " +
+					"Can also be set with the AIVEN_TOKEN_FILE environment variable.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("api_token"), path.MatchRoot("api_token_command")),
+				},
+			},
+			"api_token_command": schema.StringAttribute{
+				Description: "The command that prints the Aiven authentication token, " +
+					"the command and its arguments are separated by spaces. " +
+					"The command runs again when the API rejects the token. " +
+					"Can also be set with the AIVEN_TOKEN_COMMAND environment variable.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("api_token"), path.MatchRoot("api_token_file")),
+				},
+			},
+			"api_url": schema.StringAttribute{
+				Description: "Aiven API base URL, for instance, a local API stand-in for testing. " +
+					"Takes precedence over the AIVEN_WEB_URL environment variable, which the API clients read on their own.",
+				Optional: true,
+			},
+			"max_retries": schema.Int64Attribute{
+				Description: "The maximum number of retries of a failed API request. " +
+					"Can also be set with the AIVEN_MAX_RETRIES environment variable. The default value is `10`.",
+				Optional: true,
+			},
+			"retry_backoff": schema.StringAttribute{
+				Description: "The minimum wait time between retries, grows exponentially up to 30 seconds, " +
+					"for instance, `2s`. Can also be set with the AIVEN_RETRY_BACKOFF environment variable. " +
+					"The default value is `1s`.",
+				Optional: true,
+			},
+			"request_timeout": schema.StringAttribute{
+				Description: "The timeout of a single API request attempt, for instance, `1m`. " +
+					"Can also be set with the AIVEN_REQUEST_TIMEOUT environment variable.",
+				Optional: true,
+			},
+			"http_proxy": schema.StringAttribute{
+				Description: "HTTP proxy URL for the API requests. " +
+					"Can also be set with the AIVEN_HTTP_PROXY environment variable.",
+				Optional: true,
+			},
+			"rate_limit": schema.Float64Attribute{
+				Description: "The client-side limit of API requests per second, including retries. " +
+					"Can also be set with the AIVEN_RATE_LIMIT environment variable.",
+				Optional: true,
+			},
+			"cost_warning_threshold": schema.Float64Attribute{
+				Description: "Shows a warning in the plan when a change increases the estimated monthly cost " +
+					"of a service by more than this amount in USD, for instance, `100`. " +
+					"See the `estimated_monthly_cost` of the service resources.",
+				Optional: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			// The SDK provider has no MaxItems, so the schemas match, the validator limits the blocks instead.
+			"default_tags": schema.ListNestedBlock{
+				Description: "Tags that are added to all resources that support tags: services, projects and Kafka topics. " +
+					"The tags set in the resource take precedence.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"tags": schema.MapAttribute{
+							Description: "Key-value pairs of the default tags.",
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+			},
 		},
 		// TODO: Description and MarkdownDescription are not supported by Terraform Plugin SDK, and are features
 		//  that are only available in the Terraform Plugin Framework.
@@ -110,40 +244,109 @@ func (p *AivenProvider) Configure(
 
 	// If the client is not defined in tests
 	if p.GenClient == nil {
-		token := lo.CoalesceOrEmpty(data.APIToken.ValueString(), os.Getenv("AIVEN_TOKEN"))
-		if token == "" {
-			resp.Diagnostics.AddError(errmsg.SummaryTokenMissing, errmsg.DetailTokenMissing)
+		opts, err := httpClientOpts(&data)
+		if err != nil {
+			resp.Diagnostics.AddError(errmsg.SummaryConstructingClient, err.Error())
 			return
 		}
 
-		// Initialize the generated client
-		genClient, err := common.NewAivenGenClient(
-			common.TokenOpt(token),
+		opts = append(
+			opts,
 			common.TFVersionOpt(req.TerraformVersion),
 			common.BuildVersionOpt(p.version),
 		)
+
+		// Unset token attributes fall back to the environment variables
+		switch {
+		case data.APIToken.ValueString() != "":
+			opts = append(opts, common.TokenOpt(data.APIToken.ValueString()))
+		case data.APITokenFile.ValueString() != "":
+			opts = append(opts, common.TokenFileOpt(data.APITokenFile.ValueString()))
+		case data.APITokenCommand.ValueString() != "":
+			opts = append(opts, common.TokenCommandOpt(data.APITokenCommand.ValueString()))
+		}
+
+		// Initialize the generated client
+		genClient, err := common.NewAivenGenClient(opts...)
 		if err != nil {
-			resp.Diagnostics.AddError(errmsg.SummaryConstructingClient, err.Error())
+			resp.Diagnostics.AddError(common.ClientErrorMessage(err))
 			return
 		}
 		p.GenClient = genClient
 
 		// Initialize the handwritten client
-		client, err := common.NewAivenClient(
-			common.TokenOpt(token),
-			common.TFVersionOpt(req.TerraformVersion),
-			common.BuildVersionOpt(p.version),
-		)
+		client, err := common.NewAivenClient(opts...)
 		if err != nil {
-			resp.Diagnostics.AddError(errmsg.SummaryConstructingClient, err.Error())
+			resp.Diagnostics.AddError(common.ClientErrorMessage(err))
 			return
 		}
 		p.Client = client
 	}
 
+	tags := make(map[string]string)
+	for _, block := range data.DefaultTags {
+		maps.Copy(tags, block.Tags)
+	}
+	common.SetDefaultTags(tags)
+
+	var costWarningThreshold *float64
+	if !data.CostWarningThreshold.IsNull() {
+		costWarningThreshold = new(data.CostWarningThreshold.ValueFloat64())
+	}
+	common.SetCostWarningThreshold(costWarningThreshold)
+
 	// Pass the provider itself as the provider data
 	resp.DataSourceData = p
 	resp.ResourceData = p
+	resp.EphemeralResourceData = p
+	resp.ListResourceData = p
+	resp.ActionData = p
+}
+
+// httpClientOpts returns the client options for the set HTTP attributes.
+// Unset attributes fall back to the environment variables.
+func httpClientOpts(data *AivenProviderModel) ([]common.ClientOpt, error) {
+	var opts []common.ClientOpt
+	if v := data.APIURL.ValueString(); v != "" {
+		u, err := common.ParseURL(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid api_url: %w", err)
+		}
+		opts = append(opts, common.APIURLOpt(u))
+	}
+
+	if !data.MaxRetries.IsNull() {
+		opts = append(opts, common.MaxRetriesOpt(int(data.MaxRetries.ValueInt64())))
+	}
+
+	if v := data.RetryBackoff.ValueString(); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid retry_backoff: %w", err)
+		}
+		opts = append(opts, common.RetryBackoffOpt(d))
+	}
+
+	if v := data.RequestTimeout.ValueString(); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid request_timeout: %w", err)
+		}
+		opts = append(opts, common.RequestTimeoutOpt(d))
+	}
+
+	if v := data.HTTPProxy.ValueString(); v != "" {
+		u, err := common.ParseURL(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid http_proxy: %w", err)
+		}
+		opts = append(opts, common.HTTPProxyOpt(u))
+	}
+
+	if !data.RateLimit.IsNull() {
+		opts = append(opts, common.RateLimitOpt(data.RateLimit.ValueFloat64()))
+	}
+	return opts, nil
 }
 
 // Resources returns the resources supported by this provider.
@@ -156,10 +359,32 @@ func (p *AivenProvider) DataSources(context.Context) []func() datasource.DataSou
 	return lo.Values(DataSourcesMap())
 }
 
+// EphemeralResources returns the ephemeral resources supported by this provider.
+func (p *AivenProvider) EphemeralResources(context.Context) []func() ephemeral.EphemeralResource {
+	return lo.Values(EphemeralResourcesMap())
+}
+
+// ListResources returns the list resources supported by this provider, used by "terraform query".
+func (p *AivenProvider) ListResources(context.Context) []func() list.ListResource {
+	return append(lo.Values(ListResources()), p.sdkListResources...)
+}
+
+// Functions returns the provider functions, for instance, provider::aiven::build_id.
+func (p *AivenProvider) Functions(context.Context) []func() function.Function {
+	return functions.Functions()
+}
+
+// Actions returns the provider actions, for instance, aiven_service_power.
+func (p *AivenProvider) Actions(context.Context) []func() action.Action {
+	return actions.Actions()
+}
+
 // New returns a new provider factory for the Aiven provider.
-func New(version string) provider.Provider {
+// The sdkListResources list the SDK provider resources, because the SDK does not support list resources.
+func New(version string, sdkListResources ...func() list.ListResource) provider.Provider {
 	return &AivenProvider{
-		version: version,
+		version:          version,
+		sdkListResources: sdkListResources,
 	}
 }
 
@@ -177,12 +402,22 @@ func ResourcesMap() map[string]func() resource.Resource {
 // DataSourcesMap used to generate PLUGIN_MIGRATION.md report. See usage.
 func DataSourcesMap() map[string]func() datasource.DataSource {
 	result := map[string]func() datasource.DataSource{
-		"aiven_organization": organization.NewDataSource,
+		"aiven_organization":           organization.NewDataSource,
+		"aiven_kafka_topic_partitions": topicpartitions.NewDataSource,
 	}
 
 	if util.IsBeta() {
 		result["aiven_external_identity"] = externalidentity.NewDataSource
 	}
 
-	return lo.Assign(result, DataSources())
+	return lo.Assign(result, servicedatasource.DataSources(), DataSources())
+}
+
+// EphemeralResourcesMap merges handwritten and generated ephemeral resources.
+func EphemeralResourcesMap() map[string]func() ephemeral.EphemeralResource {
+	result := map[string]func() ephemeral.EphemeralResource{
+		"aiven_service_credentials": servicecredentials.NewEphemeralResource,
+	}
+
+	return lo.Assign(result, EphemeralResources())
 }
@@ -0,0 +1,14 @@
+// Package sensitive lists the field names that hold secrets.
+// The plugin generator marks them as sensitive, the HTTP logs redact them.
+package sensitive
+
+// Fields is a list of field names that should be marked as sensitive by default
+// even if not marked in the definitions list.
+var Fields = []string{
+	"access_cert",
+	"access_key",
+	"private_key",
+	"password",
+	"password_wo",
+	"service_uri",
+}
@@ -0,0 +1,79 @@
+package acl
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"github.com/aiven/go-client-codegen/handler/kafka"
+	"github.com/samber/lo"
+
+	"github.com/aiven/terraform-provider-aiven/internal/plugin/adapter"
+	"github.com/aiven/terraform-provider-aiven/internal/plugin/batchrepository"
+	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
+)
+
+// repo lists the ACLs once per service for all the resources being refreshed
+var repo = batchrepository.New("ACL entry", func(ctx context.Context, client avngen.Client, project, serviceName string) (map[string]*kafka.AclOut, error) {
+	list, err := client.ServiceKafkaAclList(ctx, project, serviceName)
+	if err != nil {
+		return nil, err
+	}
+	return batchrepository.Index(list, func(i int) string { return lo.FromPtr(list[i].Id) }), nil
+})
+
+var renameFields = adapter.RenameFields(map[string]string{"id": "acl_id"})
+
+func readView(ctx context.Context, client avngen.Client, d adapter.ResourceData) error {
+	project := d.Get("project").(string)
+	serviceName := d.Get("service_name").(string)
+	if d.Get("acl_id").(string) == "" {
+		// The data source looks up the ACL by its fields
+		list, err := repo.List(ctx, client, project, serviceName)
+		if err != nil {
+			return err
+		}
+
+		acls := lo.Values(list)
+		match, err := adapter.FindOne(acls, func(i int) bool {
+			return adapter.Equal(acls[i].Permission, d.Get("permission")) && adapter.Equal(acls[i].Topic, d.Get("topic")) && adapter.Equal(acls[i].Username, d.Get("username"))
+		})
+		if err != nil {
+			return fmt.Errorf("lookup `aiven_kafka_acl` by `permission`, `topic` and `username`: %w", err)
+		}
+		return d.Flatten(match, renameFields)
+	}
+
+	acl, err := repo.Read(ctx, client, project, serviceName, d.Get("acl_id").(string))
+	if err != nil {
+		return err
+	}
+	return d.Flatten(acl, renameFields)
+}
+
+func deleteView(ctx context.Context, client avngen.Client, d adapter.ResourceData) error {
+	project := d.Get("project").(string)
+	serviceName := d.Get("service_name").(string)
+	_, err := client.ServiceKafkaAclDelete(ctx, project, serviceName, d.Get("acl_id").(string))
+	repo.Forget(project, serviceName)
+	return err
+}
+
+// listView lists the ACLs of the service.
+// Caches them, so importing the found resources doesn't call the API again.
+func listView(ctx context.Context, client avngen.Client, d adapter.ResourceData) ([]string, error) {
+	project := d.Get("project").(string)
+	serviceName := d.Get("service_name").(string)
+	list, err := repo.List(ctx, client, project, serviceName)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(list))
+	for _, id := range slices.Sorted(maps.Keys(list)) {
+		ids = append(ids, schemautil.BuildResourceID(project, serviceName, id))
+	}
+	return ids, nil
+}
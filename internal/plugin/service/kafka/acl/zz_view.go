@@ -28,6 +28,7 @@ var ResourceOptions = adapter.ResourceOptions{
 	Create:         createView,
 	Delete:         deleteView,
 	IDFields:       idFields(),
+	List:           listView,
 	Read:           readView,
 	RefreshState:   &adapter.RefreshStateCondition{},
 	RemoveMissing:  true,
@@ -64,38 +65,6 @@ func createView(ctx context.Context, client avngen.Client, d adapter.ResourceDat
 	return d.Flatten(&match, adapter.RenameFields(map[string]string{"id": "acl_id"}))
 }
 
-func readView(ctx context.Context, client avngen.Client, d adapter.ResourceData) error {
-	if d.Get("acl_id").(string) == "" {
-		rsp, err := client.ServiceKafkaAclList(ctx, d.Get("project").(string), d.Get("service_name").(string))
-		if err != nil {
-			return err
-		}
-		match, err := adapter.FindOne(rsp, func(i int) bool {
-			return adapter.Equal(rsp[i].Permission, d.Get("permission")) && adapter.Equal(rsp[i].Topic, d.Get("topic")) && adapter.Equal(rsp[i].Username, d.Get("username"))
-		})
-		if err != nil {
-			return fmt.Errorf("lookup `aiven_kafka_acl` by `permission`, `topic` and `username`: %w", err)
-		}
-		return d.Flatten(&match, adapter.RenameFields(map[string]string{"id": "acl_id"}))
-	}
-	rsp, err := client.ServiceKafkaAclList(ctx, d.Get("project").(string), d.Get("service_name").(string))
-	if err != nil {
-		return err
-	}
-	match, err := adapter.FindOne(rsp, func(i int) bool {
-		return adapter.Equal(rsp[i].Id, d.Get("acl_id"))
-	})
-	if err != nil {
-		return fmt.Errorf("lookup `aiven_kafka_acl` by `acl_id`: %w", err)
-	}
-	return d.Flatten(&match, adapter.RenameFields(map[string]string{"id": "acl_id"}))
-}
-
-func deleteView(ctx context.Context, client avngen.Client, d adapter.ResourceData) error {
-	_, err := client.ServiceKafkaAclDelete(ctx, d.Get("project").(string), d.Get("service_name").(string), d.Get("acl_id").(string))
-	return err
-}
-
 func datasourceConfigValidators(ctx context.Context, client avngen.Client) []datasource.ConfigValidator {
 	return []datasource.ConfigValidator{
 		datasourcevalidator.ExactlyOneOf(
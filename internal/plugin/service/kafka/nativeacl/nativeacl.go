@@ -0,0 +1,36 @@
+package nativeacl
+
+import (
+	"context"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"github.com/aiven/go-client-codegen/handler/kafka"
+
+	"github.com/aiven/terraform-provider-aiven/internal/plugin/adapter"
+	"github.com/aiven/terraform-provider-aiven/internal/plugin/batchrepository"
+)
+
+// repo lists the ACLs once per service for all the resources being refreshed
+var repo = batchrepository.New("Kafka-native ACL entry", func(ctx context.Context, client avngen.Client, project, serviceName string) (map[string]*kafka.KafkaAclOut, error) {
+	rsp, err := client.ServiceKafkaNativeAclList(ctx, project, serviceName)
+	if err != nil {
+		return nil, err
+	}
+	return batchrepository.Index(rsp.KafkaAcl, func(i int) string { return rsp.KafkaAcl[i].Id }), nil
+})
+
+func readView(ctx context.Context, client avngen.Client, d adapter.ResourceData) error {
+	acl, err := repo.Read(ctx, client, d.Get("project").(string), d.Get("service_name").(string), d.Get("acl_id").(string))
+	if err != nil {
+		return err
+	}
+	return d.Flatten(acl, adapter.RenameFields(map[string]string{"id": "acl_id"}))
+}
+
+func deleteView(ctx context.Context, client avngen.Client, d adapter.ResourceData) error {
+	project := d.Get("project").(string)
+	serviceName := d.Get("service_name").(string)
+	err := client.ServiceKafkaNativeAclDelete(ctx, project, serviceName, d.Get("acl_id").(string))
+	repo.Forget(project, serviceName)
+	return err
+}
@@ -44,15 +44,3 @@ func createView(ctx context.Context, client avngen.Client, d adapter.ResourceDat
 	}
 	return d.Flatten(rsp, adapter.RenameFields(map[string]string{"id": "acl_id"}))
 }
-
-func readView(ctx context.Context, client avngen.Client, d adapter.ResourceData) error {
-	rsp, err := client.ServiceKafkaNativeAclGet(ctx, d.Get("project").(string), d.Get("service_name").(string), d.Get("acl_id").(string))
-	if err != nil {
-		return err
-	}
-	return d.Flatten(rsp, adapter.RenameFields(map[string]string{"id": "acl_id"}))
-}
-
-func deleteView(ctx context.Context, client avngen.Client, d adapter.ResourceData) error {
-	return client.ServiceKafkaNativeAclDelete(ctx, d.Get("project").(string), d.Get("service_name").(string), d.Get("acl_id").(string))
-}
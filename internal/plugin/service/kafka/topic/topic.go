@@ -27,6 +27,7 @@ import (
 
 	avngen "github.com/aiven/go-client-codegen"
 	"github.com/aiven/go-client-codegen/handler/kafkatopic"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 
 	"github.com/aiven/terraform-provider-aiven/internal/plugin/adapter"
 	"github.com/aiven/terraform-provider-aiven/internal/plugin/kafkatopicrepository"
@@ -46,6 +47,23 @@ func deleteView(ctx context.Context, client avngen.Client, d adapter.ResourceDat
 	)
 }
 
+// listView lists the topics of the service with kafkatopicrepository,
+// so reading the found topics is batched with V2List.
+func listView(ctx context.Context, client avngen.Client, d adapter.ResourceData) ([]string, error) {
+	project := d.Get("project").(string)
+	serviceName := d.Get("service_name").(string)
+	names, err := kafkatopicrepository.New(client).List(ctx, project, serviceName)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(names))
+	for _, name := range names {
+		ids = append(ids, schemautil.BuildResourceID(project, serviceName, name))
+	}
+	return ids, nil
+}
+
 var (
 	errTopicAlreadyExists          = errors.New("topic conflict, already exists")
 	errLocalRetentionBytesOverflow = errors.New("local_retention_bytes must not be more than retention_bytes value")
@@ -59,7 +77,7 @@ var (
 // until the next refresh.
 func createView(ctx context.Context, client avngen.Client, d adapter.ResourceData) error {
 	req := new(kafkatopic.ServiceKafkaTopicCreateIn)
-	if err := d.Expand(req, expandConfig, adapter.RenameFields(map[string]string{"tag": "tags"})); err != nil {
+	if err := d.Expand(req, expandConfig, adapter.RenameFields(map[string]string{"tag": "tags"}), adapter.ExpandDefaultTags("tags")); err != nil {
 		return err
 	}
 
@@ -82,7 +100,7 @@ func createView(ctx context.Context, client avngen.Client, d adapter.ResourceDat
 
 func updateView(ctx context.Context, client avngen.Client, d adapter.ResourceData) error {
 	req := new(kafkatopic.ServiceKafkaTopicUpdateIn)
-	if err := d.Expand(req, expandConfig, adapter.RenameFields(map[string]string{"tag": "tags"})); err != nil {
+	if err := d.Expand(req, expandConfig, adapter.RenameFields(map[string]string{"tag": "tags"}), adapter.ExpandDefaultTags("tags")); err != nil {
 		return err
 	}
 
@@ -115,6 +133,7 @@ func readView(ctx context.Context, client avngen.Client, d adapter.ResourceData)
 		flattenConfig(rsp),
 		flattenPartitions(rsp),
 		adapter.RenameFields(map[string]string{"tags": "tag"}),
+		adapter.FlattenDefaultTags("tag"),
 	)
 }
 
@@ -156,9 +175,17 @@ func validateConfig(ctx context.Context, client avngen.Client, d adapter.Resourc
 //   - partition count cannot decrease on existing resources
 //   - a topic with the same name must not already exist on the service (new
 //     resources only — existing ones are reconciled by Read)
+//   - warns when the topic can't be fully replicated, see warnReplication
+//   - marks the topic for update when the provider default_tags are not applied, see adapter.ModifyPlanDefaultTags
 //
 // Config-only checks (e.g. retention byte relationship) are in validateConfig.
 func modifyPlan(ctx context.Context, client avngen.Client, d adapter.ResourceData) error {
+	if d.IsNewResource() || d.HasChange("replication") || d.HasChange("config") {
+		if err := warnReplication(ctx, client, d); err != nil {
+			return err
+		}
+	}
+
 	if !d.IsNewResource() && d.HasChange("partitions") {
 		// The adapter stores SchemaTypeInt values as plain `int` (see
 		// marshalling.fromTFValueAny), so assert against `int`, not `int64`.
@@ -183,9 +210,107 @@ func modifyPlan(ctx context.Context, client avngen.Client, d adapter.ResourceDat
 			return fmt.Errorf("%w: %q", errTopicAlreadyExists, d.Get("topic_name").(string))
 		}
 	}
+
+	// Goes last: marking the topic for update plans the ID unknown, so it looks like a new resource
+	return adapter.ModifyPlanDefaultTags(d, "tag", func() (any, error) {
+		rsp, err := kafkatopicrepository.New(client).Read(
+			ctx,
+			d.Get("project").(string),
+			d.Get("service_name").(string),
+			d.Get("topic_name").(string),
+		)
+		if err != nil {
+			return nil, err
+		}
+		return rsp.Tags, nil
+	})
+}
+
+// warnReplication adds plan warnings when `replication` exceeds the broker count of the service,
+// or `min_insync_replicas` exceeds `replication`: producers with acks=all fail on such topics,
+// and the partitions stay under-replicated.
+// The brokers are counted only when `replication` changes, a `config` change checks `min_insync_replicas` only.
+func warnReplication(ctx context.Context, client avngen.Client, d adapter.ResourceData) error {
+	// Unknown values are nil
+	replication, ok := d.Get("replication").(int)
+	if !ok {
+		return nil
+	}
+
+	minInsyncReplicas, _ := strconv.Atoi(fmt.Sprint(d.Get("config.0.min_insync_replicas")))
+
+	brokers := 0
+	if d.IsNewResource() || d.HasChange("replication") {
+		var err error
+		brokers, err = countBrokers(ctx, client, d.Get("project").(string), d.Get("service_name").(string))
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, w := range replicationWarnings(replication, brokers, minInsyncReplicas) {
+		adapter.AddAttributeWarning(ctx, w.path, "Under-replicated topic", w.detail)
+	}
 	return nil
 }
 
+// serviceBrokers caches the broker count per service: a plan checks all the topics of the service
+var serviceBrokers schemautil.DoOnce[int]
+
+// countBrokers returns the number of the running nodes of the service, zero when the service is not created yet
+func countBrokers(ctx context.Context, client avngen.Client, project, serviceName string) (int, error) {
+	return serviceBrokers.Do(func() (int, error) {
+		s, err := client.ServiceGet(ctx, project, serviceName)
+		if avngen.IsNotFound(err) {
+			return 0, nil
+		}
+		if err != nil {
+			return 0, fmt.Errorf("failed to get the service: %w", err)
+		}
+
+		// The nodes being added or removed don't keep the replicas
+		brokers := 0
+		for _, n := range s.NodeStates {
+			if string(n.State) == "running" {
+				brokers++
+			}
+		}
+		return brokers, nil
+	}, project, serviceName)
+}
+
+type replicationWarning struct {
+	path   path.Path
+	detail string
+}
+
+// replicationWarnings returns the warnings, zero brokers or min_insync_replicas are unknown
+func replicationWarnings(replication, brokers, minInsyncReplicas int) []replicationWarning {
+	var result []replicationWarning
+	if brokers > 0 && replication > brokers {
+		result = append(result, replicationWarning{
+			path: path.Root("replication"),
+			detail: fmt.Sprintf(
+				"The replication factor %d exceeds the number of brokers %d of the service. "+
+					"The topic can't be created, or its partitions stay under-replicated.",
+				replication, brokers,
+			),
+		})
+	}
+
+	if minInsyncReplicas > replication {
+		result = append(result, replicationWarning{
+			path: path.Root("config").AtListIndex(0).AtName("min_insync_replicas"),
+			detail: fmt.Sprintf(
+				"The min_insync_replicas %d exceeds the replication factor %d. "+
+					"The producers with acks=all fail to write to the topic.",
+				minInsyncReplicas, replication,
+			),
+		})
+	}
+	return result
+}
+
 // flattenConfig keeps only user-set overrides (SourceTypeTopicConfig) to avoid
 // polluting state with service defaults, and omits the block when empty since
 // the framework has no computed blocks.
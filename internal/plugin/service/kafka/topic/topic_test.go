@@ -51,6 +51,7 @@ func TestAccAivenKafkaTopic(t *testing.T) {
 	require.Empty(t, list, "This test assumes there are no pre-existing topics in the Kafka service")
 
 	t.Run("basic", func(t *testing.T) {
+		acc.SkipIfFakeAPI(t, "the topic owner is an organization user group")
 		resourceName := "aiven_kafka_topic.foo"
 		stringsResourceName := "aiven_kafka_topic.foo_strings"
 		topic2ResourceName := "aiven_kafka_topic.topic2"
@@ -246,6 +247,7 @@ func TestAccAivenKafkaTopic(t *testing.T) {
 	// Kafka loses all topics on power-cycle without backup; refresh + apply should
 	// recreate the topic without the user having to clean state up manually.
 	t.Run("recreate_missing", func(t *testing.T) {
+		acc.SkipIfFakeAPI(t, "the fake Kafka service keeps its topics")
 		topicResource := "aiven_kafka_topic.topic"
 		topicName := acc.RandName("topic")
 		kafkaID := fmt.Sprintf("%s/%s", projectName, kafkaName)
@@ -816,6 +818,56 @@ resource "aiven_kafka_topic" "topic_conflict" {
 `, projectName, kafkaName, topicName)
 }
 
+// TestAccAivenKafkaTopic_fake_api runs against the local fake API, it needs TF_ACC only
+func TestAccAivenKafkaTopic_fake_api(t *testing.T) {
+	projectName := "test-acc"
+	kafkaName := acc.RandName("kafka")
+	topicName := acc.RandName("topic")
+	resourceName := "aiven_kafka_topic.foo"
+	provider := acc.FakeAPIProvider(t, projectName)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: provider + testAccKafkaTopicFakeAPIResource(projectName, kafkaName, topicName, 1234),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", schemautil.BuildResourceID(projectName, kafkaName, topicName)),
+					resource.TestCheckResourceAttr(resourceName, "partitions", "3"),
+					resource.TestCheckResourceAttr(resourceName, "replication", "2"),
+					resource.TestCheckResourceAttr(resourceName, "config.0.retention_bytes", "1234"),
+				),
+			},
+			{
+				Config: provider + testAccKafkaTopicFakeAPIResource(projectName, kafkaName, topicName, 4321),
+				Check:  resource.TestCheckResourceAttr(resourceName, "config.0.retention_bytes", "4321"),
+			},
+		},
+	})
+}
+
+func testAccKafkaTopicFakeAPIResource(projectName, kafkaName, topicName string, retentionBytes int) string {
+	return fmt.Sprintf(`
+resource "aiven_kafka" "bar" {
+  project      = %[1]q
+  cloud_name   = "google-europe-west1"
+  plan         = "startup-4"
+  service_name = %[2]q
+}
+
+resource "aiven_kafka_topic" "foo" {
+  project      = aiven_kafka.bar.project
+  service_name = aiven_kafka.bar.service_name
+  topic_name   = %[3]q
+  partitions   = 3
+  replication  = 2
+
+  config {
+    retention_bytes = %[4]d
+  }
+}`, projectName, kafkaName, topicName, retentionBytes)
+}
+
 func TestAccAivenKafkaTopic_config_max_items(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acc.TestAccPreCheck(t) },
@@ -1,9 +1,13 @@
 package topic
 
 import (
+	"context"
 	"testing"
 
+	avngen "github.com/aiven/go-client-codegen"
 	"github.com/aiven/go-client-codegen/handler/kafkatopic"
+	"github.com/aiven/go-client-codegen/handler/service"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/stretchr/testify/require"
 
 	"github.com/aiven/terraform-provider-aiven/internal/plugin/adapter"
@@ -160,3 +164,156 @@ func TestKafkaTopicFlattenNoConfigDoesNotPanic(t *testing.T) {
 	})
 	require.NoError(t, err)
 }
+
+func TestKafkaTopicReplicationWarnings(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name              string
+		replication       int
+		brokers           int
+		minInsyncReplicas int
+		expected          []path.Path
+	}{
+		{
+			name:              "valid",
+			replication:       3,
+			brokers:           3,
+			minInsyncReplicas: 2,
+		},
+		{
+			name:        "unknown brokers",
+			replication: 3,
+		},
+		{
+			name:        "replication exceeds brokers",
+			replication: 6,
+			brokers:     3,
+			expected:    []path.Path{path.Root("replication")},
+		},
+		{
+			name:        "replication exceeds brokers of a small service",
+			replication: 3,
+			brokers:     2,
+			expected:    []path.Path{path.Root("replication")},
+		},
+		{
+			name:              "min_insync_replicas exceeds replication",
+			replication:       2,
+			brokers:           3,
+			minInsyncReplicas: 3,
+			expected:          []path.Path{path.Root("config").AtListIndex(0).AtName("min_insync_replicas")},
+		},
+		{
+			name:              "both",
+			replication:       6,
+			brokers:           3,
+			minInsyncReplicas: 7,
+			expected: []path.Path{
+				path.Root("replication"),
+				path.Root("config").AtListIndex(0).AtName("min_insync_replicas"),
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var actual []path.Path
+			for _, w := range replicationWarnings(tc.replication, tc.brokers, tc.minInsyncReplicas) {
+				actual = append(actual, w.path)
+			}
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestKafkaTopicCountBrokers(t *testing.T) {
+	const project, serviceName = "prj", "count-brokers"
+	ctx := context.Background()
+	t.Cleanup(func() { serviceBrokers.Forget(project, serviceName) })
+
+	// The service is read once, only the running nodes are brokers
+	client := avngen.NewMockClient(t)
+	client.EXPECT().ServiceGet(ctx, project, serviceName).Return(&service.ServiceGetOut{
+		NodeStates: []service.NodeStateOut{
+			{Name: "foo-1", State: "running"},
+			{Name: "foo-2", State: "running"},
+			{Name: "foo-3", State: "leaving"},
+			{Name: "foo-4", State: "setting_up_vm"},
+		},
+	}, nil).Once()
+
+	for range 2 {
+		brokers, err := countBrokers(ctx, client, project, serviceName)
+		require.NoError(t, err)
+		require.Equal(t, 2, brokers)
+	}
+}
+
+func TestKafkaTopicWarnReplicationCountsBrokers(t *testing.T) {
+	const project = "prj"
+	ctx := context.Background()
+
+	plan := func(serviceName string, replication any, minInsyncReplicas string) map[string]any {
+		return map[string]any{
+			"id":           project + "/" + serviceName + "/topic",
+			"project":      project,
+			"service_name": serviceName,
+			"topic_name":   "topic",
+			"partitions":   3,
+			"replication":  replication,
+			"config":       []any{map[string]any{"min_insync_replicas": minInsyncReplicas}},
+		}
+	}
+
+	cases := []struct {
+		name        string
+		plan        map[string]any
+		state       map[string]any
+		expectCount bool
+	}{
+		{
+			name:        "new resource",
+			plan:        map[string]any{"project": project, "service_name": "new", "topic_name": "topic", "partitions": 3, "replication": 3},
+			expectCount: true,
+		},
+		{
+			name:        "replication changed",
+			plan:        plan("replication-changed", 3, "2"),
+			state:       plan("replication-changed", 2, "2"),
+			expectCount: true,
+		},
+		{
+			name:  "config changed",
+			plan:  plan("config-changed", 3, "3"),
+			state: plan("config-changed", 3, "2"),
+		},
+		{
+			name:  "unknown replication",
+			plan:  plan("unknown-replication", nil, "2"),
+			state: plan("unknown-replication", 2, "2"),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			serviceName := tc.plan["service_name"].(string)
+			t.Cleanup(func() { serviceBrokers.Forget(project, serviceName) })
+
+			// Fails on the unexpected calls
+			client := avngen.NewMockClient(t)
+			if tc.expectCount {
+				client.EXPECT().ServiceGet(ctx, project, serviceName).Return(&service.ServiceGetOut{}, nil).Once()
+			}
+
+			d, err := adapter.NewResourceData(resourceSchemaInternal(), idFields(),
+				adapter.WithTestPlan(tc.plan),
+				adapter.WithTestState(tc.state),
+			)
+			require.NoError(t, err)
+			require.NoError(t, warnReplication(ctx, client, d))
+		})
+	}
+}
@@ -183,7 +183,7 @@ func datasourceSchema(ctx context.Context) schema.Schema {
 				}},
 			},
 			"tag": schema.SetNestedBlock{
-				MarkdownDescription: "Topic tags.",
+				MarkdownDescription: "Topic tags. The provider `default_tags` are added to them.",
 				NestedObject: schema.NestedBlockObject{Attributes: map[string]schema.Attribute{
 					"key": schema.StringAttribute{
 						Computed:            true,
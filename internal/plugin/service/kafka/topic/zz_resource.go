@@ -269,7 +269,7 @@ func resourceSchema(ctx context.Context) schema.Schema {
 				Validators: []validator.List{listvalidator.SizeAtMost(1)},
 			},
 			"tag": schema.SetNestedBlock{
-				MarkdownDescription: "Topic tags.",
+				MarkdownDescription: "Topic tags. The provider `default_tags` are added to them.",
 				NestedObject: schema.NestedBlockObject{Attributes: map[string]schema.Attribute{
 					"key": schema.StringAttribute{
 						MarkdownDescription: "Tag key. Length must be between `1` and `64`.",
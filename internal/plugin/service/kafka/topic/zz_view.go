@@ -17,6 +17,7 @@ var ResourceOptions = adapter.ResourceOptions{
 	Create:                createView,
 	Delete:                deleteView,
 	IDFields:              idFields(),
+	List:                  listView,
 	ModifyPlan:            modifyPlan,
 	Read:                  readView,
 	RemoveMissing:         true,
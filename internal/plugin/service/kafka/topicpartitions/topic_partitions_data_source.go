@@ -0,0 +1,263 @@
+// Package topicpartitions implements the aiven_kafka_topic_partitions data source.
+//
+// The partitions are read with kafkatopicrepository, the same batched V2 topic read
+// that the aiven_kafka_topic resource uses.
+package topicpartitions
+
+import (
+	"context"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/aiven/terraform-provider-aiven/internal/plugin/kafkatopicrepository"
+	"github.com/aiven/terraform-provider-aiven/internal/plugin/providerdata"
+	"github.com/aiven/terraform-provider-aiven/internal/plugin/util"
+	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
+)
+
+var (
+	_ datasource.DataSource              = &topicPartitionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &topicPartitionsDataSource{}
+	_ util.TypeNameable                  = &topicPartitionsDataSource{}
+)
+
+func NewDataSource() datasource.DataSource {
+	return &topicPartitionsDataSource{}
+}
+
+type topicPartitionsDataSource struct {
+	client   avngen.Client
+	typeName string
+}
+
+// topicPartitionsDataSourceModel is the model for the kafka_topic_partitions data source.
+type topicPartitionsDataSourceModel struct {
+	ID                        types.String     `tfsdk:"id"`
+	Project                   types.String     `tfsdk:"project"`
+	ServiceName               types.String     `tfsdk:"service_name"`
+	TopicName                 types.String     `tfsdk:"topic_name"`
+	Replication               types.Int64      `tfsdk:"replication"`
+	UnderReplicatedPartitions types.Int64      `tfsdk:"under_replicated_partitions"`
+	Partitions                []partitionModel `tfsdk:"partitions"`
+}
+
+type partitionModel struct {
+	Partition       types.Int64          `tfsdk:"partition"`
+	ISR             types.Int64          `tfsdk:"isr"`
+	UnderReplicated types.Bool           `tfsdk:"under_replicated"`
+	EarliestOffset  types.Int64          `tfsdk:"earliest_offset"`
+	LatestOffset    types.Int64          `tfsdk:"latest_offset"`
+	Size            types.Int64          `tfsdk:"size"`
+	RemoteSize      types.Int64          `tfsdk:"remote_size"`
+	ConsumerGroups  []consumerGroupModel `tfsdk:"consumer_groups"`
+}
+
+type consumerGroupModel struct {
+	GroupName types.String `tfsdk:"group_name"`
+	Offset    types.Int64  `tfsdk:"offset"`
+}
+
+// topicOut is the part of the V2 topic read response with the partitions
+type topicOut struct {
+	Replication int `json:"replication"`
+	Partitions  []struct {
+		Partition      int   `json:"partition"`
+		ISR            int   `json:"isr"`
+		EarliestOffset int64 `json:"earliest_offset"`
+		LatestOffset   int64 `json:"latest_offset"`
+		Size           int64 `json:"size"`
+		RemoteSize     int64 `json:"remote_size"`
+		ConsumerGroups []struct {
+			GroupName string `json:"group_name"`
+			Offset    int64  `json:"offset"`
+		} `json:"consumer_groups"`
+	} `json:"partitions"`
+}
+
+// Metadata returns the metadata for the kafka_topic_partitions data source.
+func (r *topicPartitionsDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_kafka_topic_partitions"
+	r.typeName = resp.TypeName
+}
+
+// TypeName returns the data source type name for the kafka_topic_partitions data source.
+func (r *topicPartitionsDataSource) TypeName() string {
+	return r.typeName
+}
+
+// Schema defines the schema for the kafka_topic_partitions data source.
+func (r *topicPartitionsDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Gets the partitions of an Aiven for Apache Kafka® topic: the in-sync replicas, offsets, sizes and consumer group offsets. " +
+			"Use it to find under-replicated partitions. " +
+			"The partition leaders are not available: the Aiven API returns the number of in-sync replicas only, not the brokers.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Resource ID composed as: `project/service_name/topic_name`.",
+				Computed:    true,
+			},
+			"project": schema.StringAttribute{
+				Description: "The name of the project the service belongs to.",
+				Required:    true,
+			},
+			"service_name": schema.StringAttribute{
+				Description: "The name of the Kafka service.",
+				Required:    true,
+			},
+			"topic_name": schema.StringAttribute{
+				Description: "The name of the topic.",
+				Required:    true,
+			},
+			"replication": schema.Int64Attribute{
+				Description: "The replication factor of the topic.",
+				Computed:    true,
+			},
+			"under_replicated_partitions": schema.Int64Attribute{
+				Description: "The number of partitions with fewer in-sync replicas than `replication`.",
+				Computed:    true,
+			},
+			"partitions": schema.ListNestedAttribute{
+				Description: "The partitions of the topic.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"partition": schema.Int64Attribute{
+							Description: "The partition number.",
+							Computed:    true,
+						},
+						"isr": schema.Int64Attribute{
+							Description: "The number of in-sync replicas.",
+							Computed:    true,
+						},
+						"under_replicated": schema.BoolAttribute{
+							Description: "Whether the partition has fewer in-sync replicas than `replication`.",
+							Computed:    true,
+						},
+						"earliest_offset": schema.Int64Attribute{
+							Description: "The earliest offset of the partition.",
+							Computed:    true,
+						},
+						"latest_offset": schema.Int64Attribute{
+							Description: "The latest offset of the partition.",
+							Computed:    true,
+						},
+						"size": schema.Int64Attribute{
+							Description: "The size of the partition in bytes.",
+							Computed:    true,
+						},
+						"remote_size": schema.Int64Attribute{
+							Description: "The size of the partition in the tiered storage in bytes.",
+							Computed:    true,
+						},
+						"consumer_groups": schema.ListNestedAttribute{
+							Description: "The consumer groups of the partition.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"group_name": schema.StringAttribute{
+										Description: "The consumer group name.",
+										Computed:    true,
+									},
+									"offset": schema.Int64Attribute{
+										Description: "The committed offset of the consumer group.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure sets up the kafka_topic_partitions data source.
+func (r *topicPartitionsDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, diags := providerdata.FromRequest(req.ProviderData)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	r.client = p.GetGenClient()
+}
+
+// Read reads a kafka_topic_partitions data source.
+func (r *topicPartitionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state topicPartitionsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project := state.Project.ValueString()
+	serviceName := state.ServiceName.ValueString()
+	topicName := state.TopicName.ValueString()
+
+	rsp, err := kafkatopicrepository.New(r.client).Read(ctx, project, serviceName, topicName)
+	if err != nil {
+		resp.Diagnostics = util.DiagErrorReadingDataSource(resp.Diagnostics, r, err)
+		return
+	}
+
+	topic := new(topicOut)
+	if err := schemautil.Remarshal(rsp, topic); err != nil {
+		resp.Diagnostics = util.DiagErrorReadingDataSource(resp.Diagnostics, r, err)
+		return
+	}
+
+	underReplicated := 0
+	partitions := make([]partitionModel, 0, len(topic.Partitions))
+	for _, p := range topic.Partitions {
+		groups := make([]consumerGroupModel, 0, len(p.ConsumerGroups))
+		for _, g := range p.ConsumerGroups {
+			groups = append(groups, consumerGroupModel{
+				GroupName: types.StringValue(g.GroupName),
+				Offset:    types.Int64Value(g.Offset),
+			})
+		}
+
+		isUnderReplicated := p.ISR < topic.Replication
+		if isUnderReplicated {
+			underReplicated++
+		}
+
+		partitions = append(partitions, partitionModel{
+			Partition:       types.Int64Value(int64(p.Partition)),
+			ISR:             types.Int64Value(int64(p.ISR)),
+			UnderReplicated: types.BoolValue(isUnderReplicated),
+			EarliestOffset:  types.Int64Value(p.EarliestOffset),
+			LatestOffset:    types.Int64Value(p.LatestOffset),
+			Size:            types.Int64Value(p.Size),
+			RemoteSize:      types.Int64Value(p.RemoteSize),
+			ConsumerGroups:  groups,
+		})
+	}
+
+	state.ID = types.StringValue(schemautil.BuildResourceID(project, serviceName, topicName))
+	state.Replication = types.Int64Value(int64(topic.Replication))
+	state.UnderReplicatedPartitions = types.Int64Value(int64(underReplicated))
+	state.Partitions = partitions
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
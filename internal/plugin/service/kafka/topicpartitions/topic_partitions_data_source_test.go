@@ -0,0 +1,136 @@
+package topicpartitions_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/require"
+
+	acc "github.com/aiven/terraform-provider-aiven/internal/acctest"
+)
+
+func TestAccAivenKafkaTopicPartitionsDataSource(t *testing.T) {
+	projectName := acc.ProjectName()
+	kafkaName := acc.RandName("kafka")
+	topicName := acc.RandName("topic")
+	dataSourceName := "data.aiven_kafka_topic_partitions.foo"
+
+	serviceIsReady := acc.CreateTestService(
+		t,
+		projectName,
+		kafkaName,
+		acc.WithServiceType("kafka"),
+		acc.WithPlan("startup-4"),
+		acc.WithCloud("google-europe-west1"),
+	)
+	require.NoError(t, <-serviceIsReady)
+
+	warnings := new(planWarnings)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: warnings.providerFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKafkaTopicPartitionsDataSource(projectName, kafkaName, topicName, 2, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "replication", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "under_replicated_partitions", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "partitions.#", "3"),
+					resource.TestCheckResourceAttr(dataSourceName, "partitions.0.partition", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "partitions.0.isr", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "partitions.0.under_replicated", "false"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "partitions.0.latest_offset"),
+				),
+			},
+			{
+				// min_insync_replicas exceeds replication: the plan shows a warning, not an error
+				Config:             testAccKafkaTopicPartitionsDataSource(projectName, kafkaName, topicName, 2, "3"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				// The plan steps can't run checks, the warning of the previous step is checked here
+				Config: testAccKafkaTopicPartitionsDataSource(projectName, kafkaName, topicName, 2, "2"),
+				Check: warnings.contains(
+					"The min_insync_replicas 3 exceeds the replication factor 2. " +
+						"The producers with acks=all fail to write to the topic.",
+				),
+			},
+		},
+	})
+}
+
+func testAccKafkaTopicPartitionsDataSource(projectName, kafkaName, topicName string, replication int, minInsyncReplicas string) string {
+	return fmt.Sprintf(`
+resource "aiven_kafka_topic" "foo" {
+  project      = %[1]q
+  service_name = %[2]q
+  topic_name   = %[3]q
+  partitions   = 3
+  replication  = %[4]d
+
+  config {
+    min_insync_replicas = %[5]q
+  }
+}
+
+data "aiven_kafka_topic_partitions" "foo" {
+  project      = aiven_kafka_topic.foo.project
+  service_name = aiven_kafka_topic.foo.service_name
+  topic_name   = aiven_kafka_topic.foo.topic_name
+}`, projectName, kafkaName, topicName, replication, minInsyncReplicas)
+}
+
+// planWarnings records the details of the plan warnings, the test steps can't check them otherwise
+type planWarnings struct {
+	mu      sync.Mutex
+	details []string
+}
+
+func (w *planWarnings) providerFactories() map[string]func() (tfprotov6.ProviderServer, error) {
+	return map[string]func() (tfprotov6.ProviderServer, error){
+		"aiven": func() (tfprotov6.ProviderServer, error) {
+			server, err := acc.TestProtoV6ProviderFactories["aiven"]()
+			if err != nil {
+				return nil, err
+			}
+			return &planWarningsServer{ProviderServer: server, warnings: w}, nil
+		},
+	}
+}
+
+func (w *planWarnings) contains(detail string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		w.mu.Lock()
+		defer w.mu.Unlock()
+		if !slices.Contains(w.details, detail) {
+			return fmt.Errorf("expected the plan warning %q, got %q", detail, w.details)
+		}
+		return nil
+	}
+}
+
+type planWarningsServer struct {
+	tfprotov6.ProviderServer
+	warnings *planWarnings
+}
+
+func (s *planWarningsServer) PlanResourceChange(ctx context.Context, req *tfprotov6.PlanResourceChangeRequest) (*tfprotov6.PlanResourceChangeResponse, error) {
+	resp, err := s.ProviderServer.PlanResourceChange(ctx, req)
+	if resp != nil {
+		s.warnings.mu.Lock()
+		for _, d := range resp.Diagnostics {
+			if d.Severity == tfprotov6.DiagnosticSeverityWarning {
+				s.warnings.details = append(s.warnings.details, d.Detail)
+			}
+		}
+		s.warnings.mu.Unlock()
+	}
+	return resp, err
+}
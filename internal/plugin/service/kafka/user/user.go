@@ -4,20 +4,64 @@ import (
 	"context"
 
 	avngen "github.com/aiven/go-client-codegen"
+	"github.com/aiven/go-client-codegen/handler/service"
 
 	"github.com/aiven/terraform-provider-aiven/internal/plugin/adapter"
+	"github.com/aiven/terraform-provider-aiven/internal/plugin/batchrepository"
 	"github.com/aiven/terraform-provider-aiven/internal/plugin/serviceuser"
 )
 
 func init() {
-	ResourceOptions.Update = serviceuser.ResetPassword
+	ResourceOptions.Update = updateView
 	ResourceOptions.RefreshStateCheck = serviceuser.PasswordIsReady
+
+	// The credentials are always read from the API, not from the repository cache
+	EphemeralResourceOptions.Open = openView
 }
 
+// repo reads the users once per service for all the resources being refreshed
+var repo = batchrepository.New("Service user", func(ctx context.Context, client avngen.Client, project, serviceName string) (map[string]*service.UserOut, error) {
+	s, err := client.ServiceGet(ctx, project, serviceName)
+	if err != nil {
+		return nil, err
+	}
+	return batchrepository.Index(s.Users, func(i int) string { return s.Users[i].Username }), nil
+})
+
 func createView(ctx context.Context, client avngen.Client, d adapter.ResourceData) error {
 	return serviceuser.Create(ctx, client, d)
 }
 
+func readView(ctx context.Context, client avngen.Client, d adapter.ResourceData) error {
+	user, err := repo.Read(ctx, client, d.Get("project").(string), d.Get("service_name").(string), d.Get("username").(string))
+	if err != nil {
+		return err
+	}
+	return d.Flatten(user, flattenModifier(ctx, client))
+}
+
+func updateView(ctx context.Context, client avngen.Client, d adapter.ResourceData) error {
+	err := serviceuser.ResetPassword(ctx, client, d)
+	repo.Forget(d.Get("project").(string), d.Get("service_name").(string))
+	return err
+}
+
+func deleteView(ctx context.Context, client avngen.Client, d adapter.ResourceData) error {
+	project := d.Get("project").(string)
+	serviceName := d.Get("service_name").(string)
+	err := client.ServiceUserDelete(ctx, project, serviceName, d.Get("username").(string))
+	repo.Forget(project, serviceName)
+	return err
+}
+
+func openView(ctx context.Context, client avngen.Client, d adapter.ResourceData) error {
+	rsp, err := client.ServiceUserGet(ctx, d.Get("project").(string), d.Get("service_name").(string), d.Get("username").(string))
+	if err != nil {
+		return err
+	}
+	return d.Flatten(rsp, flattenModifier(ctx, client))
+}
+
 func flattenModifier(_ context.Context, _ avngen.Client) adapter.MapModifier {
 	return serviceuser.PasswordFlatten
 }
@@ -3,16 +3,12 @@
 
 package user
 
-import (
-	"context"
-
-	avngen "github.com/aiven/go-client-codegen"
-
-	"github.com/aiven/terraform-provider-aiven/internal/plugin/adapter"
-)
+import "github.com/aiven/terraform-provider-aiven/internal/plugin/adapter"
 
 const typeName = "aiven_kafka_user"
 
+const ephemeralTypeName = "aiven_kafka_user_credentials"
+
 // idFields the ID attribute fields, i.e.:
 // terraform import aiven_kafka_user.foo PROJECT/SERVICE_NAME/USERNAME
 func idFields() []string {
@@ -39,14 +35,9 @@ var DataSourceOptions = adapter.DataSourceOptions{
 	TypeName:       typeName,
 }
 
-func readView(ctx context.Context, client avngen.Client, d adapter.ResourceData) error {
-	rsp, err := client.ServiceUserGet(ctx, d.Get("project").(string), d.Get("service_name").(string), d.Get("username").(string))
-	if err != nil {
-		return err
-	}
-	return d.Flatten(rsp, flattenModifier(ctx, client))
-}
-
-func deleteView(ctx context.Context, client avngen.Client, d adapter.ResourceData) error {
-	return client.ServiceUserDelete(ctx, d.Get("project").(string), d.Get("service_name").(string), d.Get("username").(string))
+var EphemeralResourceOptions = adapter.EphemeralResourceOptions{
+	IDFields:       idFields(),
+	Schema:         ephemeralSchema,
+	SchemaInternal: ephemeralSchemaInternal(),
+	TypeName:       ephemeralTypeName,
 }
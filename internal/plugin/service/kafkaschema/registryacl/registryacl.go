@@ -0,0 +1,59 @@
+package registryacl
+
+import (
+	"context"
+	"fmt"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"github.com/aiven/go-client-codegen/handler/kafkaschemaregistry"
+	"github.com/samber/lo"
+
+	"github.com/aiven/terraform-provider-aiven/internal/plugin/adapter"
+	"github.com/aiven/terraform-provider-aiven/internal/plugin/batchrepository"
+)
+
+// repo lists the ACLs once per service for all the resources being refreshed
+var repo = batchrepository.New("Schema Registry ACL entry", func(ctx context.Context, client avngen.Client, project, serviceName string) (map[string]*kafkaschemaregistry.AclOut, error) {
+	list, err := client.ServiceSchemaRegistryAclList(ctx, project, serviceName)
+	if err != nil {
+		return nil, err
+	}
+	return batchrepository.Index(list, func(i int) string { return lo.FromPtr(list[i].Id) }), nil
+})
+
+var renameFields = adapter.RenameFields(map[string]string{"id": "acl_id"})
+
+func readView(ctx context.Context, client avngen.Client, d adapter.ResourceData) error {
+	project := d.Get("project").(string)
+	serviceName := d.Get("service_name").(string)
+	if d.Get("acl_id").(string) == "" {
+		// The data source looks up the ACL by its fields
+		list, err := repo.List(ctx, client, project, serviceName)
+		if err != nil {
+			return err
+		}
+
+		acls := lo.Values(list)
+		match, err := adapter.FindOne(acls, func(i int) bool {
+			return adapter.Equal(acls[i].Permission, d.Get("permission")) && adapter.Equal(acls[i].Resource, d.Get("resource")) && adapter.Equal(acls[i].Username, d.Get("username"))
+		})
+		if err != nil {
+			return fmt.Errorf("lookup `aiven_kafka_schema_registry_acl` by `permission`, `resource` and `username`: %w", err)
+		}
+		return d.Flatten(match, renameFields)
+	}
+
+	acl, err := repo.Read(ctx, client, project, serviceName, d.Get("acl_id").(string))
+	if err != nil {
+		return err
+	}
+	return d.Flatten(acl, renameFields)
+}
+
+func deleteView(ctx context.Context, client avngen.Client, d adapter.ResourceData) error {
+	project := d.Get("project").(string)
+	serviceName := d.Get("service_name").(string)
+	_, err := client.ServiceSchemaRegistryAclDelete(ctx, project, serviceName, d.Get("acl_id").(string))
+	repo.Forget(project, serviceName)
+	return err
+}
@@ -13,6 +13,8 @@ import (
 
 const typeName = "aiven_mysql_user"
 
+const ephemeralTypeName = "aiven_mysql_user_credentials"
+
 // idFields the ID attribute fields, i.e.:
 // terraform import aiven_mysql_user.foo PROJECT/SERVICE_NAME/USERNAME
 func idFields() []string {
@@ -39,6 +41,14 @@ var DataSourceOptions = adapter.DataSourceOptions{
 	TypeName:       typeName,
 }
 
+var EphemeralResourceOptions = adapter.EphemeralResourceOptions{
+	IDFields:       idFields(),
+	Open:           readView,
+	Schema:         ephemeralSchema,
+	SchemaInternal: ephemeralSchemaInternal(),
+	TypeName:       ephemeralTypeName,
+}
+
 func readView(ctx context.Context, client avngen.Client, d adapter.ResourceData) error {
 	rsp, err := client.ServiceUserGet(ctx, d.Get("project").(string), d.Get("service_name").(string), d.Get("username").(string))
 	if err != nil {
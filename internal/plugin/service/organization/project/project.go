@@ -14,6 +14,7 @@ func expandModifier(ctx context.Context, client avngen.Client) adapter.MapModifi
 	return adapter.ComposeMapModifiers(
 		expandParentID(ctx, client),
 		ExpandKeyValue("tag", true),
+		adapter.ExpandDefaultTags("tag"),
 		billinggroup.ExpandEmails("technical_emails"),
 	)
 }
@@ -22,10 +23,22 @@ func flattenModifier(_ context.Context, _ avngen.Client) adapter.MapModifier {
 	return adapter.ComposeMapModifiers(
 		flattenParentID,
 		FlattenKeyValue("tag"),
+		adapter.FlattenDefaultTags("tag"),
 		billinggroup.FlattenEmails("technical_emails"),
 	)
 }
 
+// modifyPlan marks the project for update when the API tags don't have the provider default_tags.
+func modifyPlan(ctx context.Context, client avngen.Client, d adapter.ResourceData) error {
+	return adapter.ModifyPlanDefaultTags(d, "tag", func() (any, error) {
+		rsp, err := client.OrganizationProjectsGet(ctx, d.Get("organization_id").(string), d.Get("project_id").(string))
+		if err != nil {
+			return nil, err
+		}
+		return rsp.Tags, nil
+	})
+}
+
 // expandParentID Converts OrganizationID to AccountID in parent_id field because that's what the API expects.
 func expandParentID(ctx context.Context, client avngen.Client) adapter.MapModifier {
 	return func(d adapter.ResourceData, dto map[string]any) error {
@@ -56,7 +56,7 @@ func datasourceSchema(ctx context.Context) schema.Schema {
 		},
 		Blocks: map[string]schema.Block{
 			"tag": schema.SetNestedBlock{
-				MarkdownDescription: "Tags are key-value pairs that allow you to categorize projects.",
+				MarkdownDescription: "Tags are key-value pairs that allow you to categorize projects. The provider `default_tags` are added to them.",
 				NestedObject: schema.NestedBlockObject{Attributes: map[string]schema.Attribute{
 					"key": schema.StringAttribute{
 						Computed:            true,
@@ -24,6 +24,7 @@ var ResourceOptions = adapter.ResourceOptions{
 	Create:         createView,
 	Delete:         deleteView,
 	IDFields:       idFields(),
+	ModifyPlan:     modifyPlan,
 	Read:           readView,
 	RefreshState:   &adapter.RefreshStateCondition{},
 	Schema:         resourceSchema,
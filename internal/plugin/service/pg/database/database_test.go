@@ -181,7 +181,7 @@ func TestAccAivenPGDatabase(t *testing.T) {
 					},
 				},
 				{
-					// Powers off the service to get ErrServicePoweredOff.
+					// Powers off the service to get ErrServicePoweredOff from the data source.
 					Config: configTerminationNil,
 					PreConfig: func() {
 						err := servicePowerOn(t, projectName, serviceName, false)
@@ -189,6 +189,11 @@ func TestAccAivenPGDatabase(t *testing.T) {
 					},
 					ExpectError: regexp.MustCompile(schemautil.ErrServicePoweredOff.Error()),
 				},
+				{
+					// The database of a powered off service is not refreshed and keeps its state.
+					Config:   testAccPGDatabase(projectName, serviceName, dbName),
+					PlanOnly: true,
+				},
 				{
 					// Powers on the service: a database can't be destroyed while the service is powered off.
 					Config: configTerminationNil,
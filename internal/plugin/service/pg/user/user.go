@@ -19,6 +19,10 @@ func createView(ctx context.Context, client avngen.Client, d adapter.ResourceDat
 	return serviceuser.Create(ctx, client, d, expandModifier(ctx, client))
 }
 
+func listView(ctx context.Context, client avngen.Client, d adapter.ResourceData) ([]string, error) {
+	return serviceuser.List(ctx, client, d)
+}
+
 func updateView(ctx context.Context, client avngen.Client, d adapter.ResourceData) error {
 	if d.HasChange("pg_allow_replication") {
 		req := &service.ServiceUserCredentialsModifyIn{
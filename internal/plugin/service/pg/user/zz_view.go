@@ -13,6 +13,8 @@ import (
 
 const typeName = "aiven_pg_user"
 
+const ephemeralTypeName = "aiven_pg_user_credentials"
+
 // idFields the ID attribute fields, i.e.:
 // terraform import aiven_pg_user.foo PROJECT/SERVICE_NAME/USERNAME
 func idFields() []string {
@@ -23,6 +25,7 @@ var ResourceOptions = adapter.ResourceOptions{
 	Create:         createView,
 	Delete:         deleteView,
 	IDFields:       idFields(),
+	List:           listView,
 	Read:           readView,
 	RefreshState:   &adapter.RefreshStateCondition{},
 	RemoveMissing:  true,
@@ -39,6 +42,14 @@ var DataSourceOptions = adapter.DataSourceOptions{
 	TypeName:       typeName,
 }
 
+var EphemeralResourceOptions = adapter.EphemeralResourceOptions{
+	IDFields:       idFields(),
+	Open:           readView,
+	Schema:         ephemeralSchema,
+	SchemaInternal: ephemeralSchemaInternal(),
+	TypeName:       ephemeralTypeName,
+}
+
 func readView(ctx context.Context, client avngen.Client, d adapter.ResourceData) error {
 	rsp, err := client.ServiceUserGet(ctx, d.Get("project").(string), d.Get("service_name").(string), d.Get("username").(string))
 	if err != nil {
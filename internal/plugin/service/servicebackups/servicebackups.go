@@ -0,0 +1,89 @@
+package servicebackups
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	avngen "github.com/aiven/go-client-codegen"
+
+	"github.com/aiven/terraform-provider-aiven/internal/plugin/adapter"
+)
+
+type backup struct {
+	dto  map[string]any
+	time time.Time
+}
+
+// flattenModifier sorts the backups from the most recent, applies the filters
+// and replaces the incremental flag with base_backup.
+func flattenModifier(_ context.Context, _ avngen.Client) adapter.MapModifier {
+	return func(d adapter.ResourceData, dto map[string]any) error {
+		from, err := getTime(d, "from_time")
+		if err != nil {
+			return err
+		}
+
+		to, err := getTime(d, "to_time")
+		if err != nil {
+			return err
+		}
+
+		list, _ := dto["backups"].([]any)
+		backups := make([]backup, 0, len(list))
+		for _, item := range list {
+			m, ok := item.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			v, _ := m["backup_time"].(string)
+			t, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				return fmt.Errorf("invalid backup_time of the backup %q: %w", m["backup_name"], err)
+			}
+
+			if (from != nil && t.Before(*from)) || (to != nil && t.After(*to)) {
+				continue
+			}
+
+			// Doesn't modify the input
+			m = maps.Clone(m)
+			incremental, _ := m["incremental"].(bool)
+			m["base_backup"] = !incremental
+			delete(m, "incremental")
+			backups = append(backups, backup{dto: m, time: t})
+		}
+
+		slices.SortStableFunc(backups, func(a, b backup) int {
+			return b.time.Compare(a.time)
+		})
+
+		if n, ok := d.GetOk("most_recent"); ok && n.(int) < len(backups) {
+			backups = backups[:n.(int)]
+		}
+
+		result := make([]any, 0, len(backups))
+		for _, b := range backups {
+			result = append(result, b.dto)
+		}
+		dto["backups"] = result
+		return nil
+	}
+}
+
+// getTime returns the time of the filter, nil if it is not set
+func getTime(d adapter.ResourceData, key string) (*time.Time, error) {
+	v, ok := d.GetOk(key)
+	if !ok || v.(string) == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, v.(string))
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s, expected RFC3339 format: %w", key, err)
+	}
+	return &t, nil
+}
@@ -0,0 +1,53 @@
+package servicebackups_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	acc "github.com/aiven/terraform-provider-aiven/internal/acctest"
+)
+
+// TestAccAivenServiceBackupsDataSource creates a PG service, which is created with its first backup
+func TestAccAivenServiceBackupsDataSource(t *testing.T) {
+	var (
+		projectName    = acc.ProjectName()
+		dataSourceName = "data.aiven_service_backups.backups"
+		serviceName    = fmt.Sprintf("test-acc-service-backups-%s", acc.RandStr())
+	)
+
+	config := fmt.Sprintf(`
+resource "aiven_pg" "bar" {
+  project      = %q
+  cloud_name   = "google-europe-west1"
+  plan         = "startup-4"
+  service_name = %q
+}
+
+data "aiven_service_backups" "backups" {
+  project      = aiven_pg.bar.project
+  service_name = aiven_pg.bar.service_name
+  most_recent  = 1
+}
+`, projectName, serviceName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestProtoV6ProviderFactories,
+		CheckDestroy:             acc.TestAccCheckAivenServiceResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", projectName+"/"+serviceName),
+					resource.TestCheckResourceAttr(dataSourceName, "backups.#", "1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "backups.0.backup_name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "backups.0.backup_time"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "backups.0.data_size"),
+					resource.TestCheckResourceAttr(dataSourceName, "backups.0.base_backup", "true"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,116 @@
+package servicebackups
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aiven/terraform-provider-aiven/internal/plugin/adapter"
+)
+
+func TestFlattenModifier(t *testing.T) {
+	t.Parallel()
+
+	// The parallel subtests get their own response
+	newRsp := func() map[string]any {
+		return map[string]any{
+			"backups": []any{
+				map[string]any{"backup_name": "a", "backup_time": "2026-01-01T00:00:00Z", "data_size": 1},
+				map[string]any{"backup_name": "c", "backup_time": "2026-01-03T00:00:00.123456Z", "data_size": 3, "incremental": true},
+				map[string]any{"backup_name": "b", "backup_time": "2026-01-02T00:00:00Z", "data_size": 2, "storage_location": "s3"},
+			},
+		}
+	}
+
+	tests := []struct {
+		name   string
+		config map[string]any
+		want   []string
+	}{
+		{name: "all from the most recent", want: []string{"c", "b", "a"}},
+		{name: "most recent", config: map[string]any{"most_recent": 2}, want: []string{"c", "b"}},
+		{name: "more than available", config: map[string]any{"most_recent": 5}, want: []string{"c", "b", "a"}},
+		{name: "from time", config: map[string]any{"from_time": "2026-01-02T00:00:00Z"}, want: []string{"c", "b"}},
+		{name: "to time", config: map[string]any{"to_time": "2026-01-02T00:00:00Z"}, want: []string{"b", "a"}},
+		{
+			name:   "time window and most recent",
+			config: map[string]any{"from_time": "2026-01-01T12:00:00Z", "to_time": "2026-01-03T12:00:00+02:00", "most_recent": 1},
+			want:   []string{"c"},
+		},
+		{name: "no match", config: map[string]any{"from_time": "2026-02-01T00:00:00Z"}, want: []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			config := map[string]any{"project": "foo", "service_name": "bar"}
+			for k, v := range tt.config {
+				config[k] = v
+			}
+
+			d, err := adapter.NewResourceData(
+				datasourceSchemaInternal(),
+				idFields(),
+				adapter.WithIsDataSource(),
+				adapter.WithTestConfig(config),
+			)
+			require.NoError(t, err)
+
+			require.NoError(t, d.Flatten(newRsp(), flattenModifier(t.Context(), nil)))
+
+			backups, _ := d.Get("backups").([]any)
+			names := make([]string, 0, len(backups))
+			for _, b := range backups {
+				names = append(names, b.(map[string]any)["backup_name"].(string))
+			}
+			assert.Equal(t, tt.want, names)
+		})
+	}
+}
+
+func TestFlattenModifierBaseBackup(t *testing.T) {
+	t.Parallel()
+
+	d, err := adapter.NewResourceData(
+		datasourceSchemaInternal(),
+		idFields(),
+		adapter.WithIsDataSource(),
+		adapter.WithTestConfig(map[string]any{"project": "foo", "service_name": "bar"}),
+	)
+	require.NoError(t, err)
+
+	in := map[string]any{
+		"backups": []any{
+			map[string]any{"backup_name": "full", "backup_time": "2026-01-01T00:00:00Z"},
+			map[string]any{"backup_name": "incremental", "backup_time": "2026-01-02T00:00:00Z", "incremental": true},
+		},
+	}
+	require.NoError(t, d.Flatten(in, flattenModifier(t.Context(), nil)))
+	backups, _ := d.Get("backups").([]any)
+	require.Len(t, backups, 2)
+	assert.Equal(t, false, backups[0].(map[string]any)["base_backup"])
+	assert.Equal(t, true, backups[1].(map[string]any)["base_backup"])
+
+	// The backups of the input are not modified
+	incremental := map[string]any{"backup_name": "incremental", "backup_time": "2026-01-02T00:00:00Z", "incremental": true}
+	dto := map[string]any{"backups": []any{incremental}}
+	require.NoError(t, flattenModifier(t.Context(), nil)(d, dto))
+	assert.Equal(t, map[string]any{"backup_name": "incremental", "backup_time": "2026-01-02T00:00:00Z", "incremental": true}, incremental)
+	assert.Equal(t, false, dto["backups"].([]any)[0].(map[string]any)["base_backup"])
+}
+
+func TestFlattenModifierInvalidTime(t *testing.T) {
+	t.Parallel()
+
+	d, err := adapter.NewResourceData(
+		datasourceSchemaInternal(),
+		idFields(),
+		adapter.WithIsDataSource(),
+		adapter.WithTestConfig(map[string]any{"project": "foo", "service_name": "bar", "from_time": "yesterday"}),
+	)
+	require.NoError(t, err)
+
+	err = d.Flatten(map[string]any{"backups": []any{}}, flattenModifier(t.Context(), nil))
+	assert.ErrorContains(t, err, "invalid from_time, expected RFC3339 format")
+}
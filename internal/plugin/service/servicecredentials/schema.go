@@ -0,0 +1,91 @@
+package servicecredentials
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/ephemeral/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+
+	"github.com/aiven/terraform-provider-aiven/internal/plugin/adapter"
+)
+
+func ephemeralSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Resource ID composed as: `project/service_name`.",
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "The name of the project this resource belongs to.",
+				Required:            true,
+			},
+			"service_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the service.",
+				Required:            true,
+			},
+			"service_host": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The hostname of the service.",
+			},
+			"service_password": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Password used for connecting to the service, if applicable.",
+				Sensitive:           true,
+			},
+			"service_port": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The port of the service.",
+			},
+			"service_uri": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "URI for connecting to the service. Service specific info is under \"kafka\", \"pg\", etc.",
+				Sensitive:           true,
+			},
+			"service_username": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Username used for connecting to the service, if applicable.",
+			},
+		},
+		Blocks:              map[string]schema.Block{"timeouts": timeouts.Block(ctx)},
+		MarkdownDescription: "Reads the service URI and the admin credentials of an Aiven service. The credentials are never stored in the plan or state.",
+	}
+}
+
+func ephemeralSchemaInternal() *adapter.Schema {
+	return &adapter.Schema{
+		Properties: map[string]*adapter.Schema{
+			"id": {
+				Computed: true,
+				Type:     adapter.SchemaTypeString,
+			},
+			"project":      {Type: adapter.SchemaTypeString},
+			"service_name": {Type: adapter.SchemaTypeString},
+			"service_host": {
+				Computed: true,
+				Type:     adapter.SchemaTypeString,
+			},
+			"service_password": {
+				Computed: true,
+				Type:     adapter.SchemaTypeString,
+			},
+			"service_port": {
+				Computed: true,
+				Type:     adapter.SchemaTypeInt,
+			},
+			"service_uri": {
+				Computed: true,
+				Type:     adapter.SchemaTypeString,
+			},
+			"service_username": {
+				Computed: true,
+				Type:     adapter.SchemaTypeString,
+			},
+			"timeouts": {
+				Properties: map[string]*adapter.Schema{"open": {Type: adapter.SchemaTypeString}},
+				Type:       adapter.SchemaTypeObject,
+			},
+		},
+		Type: adapter.SchemaTypeObject,
+	}
+}
@@ -0,0 +1,80 @@
+// Package servicecredentials implements the aiven_service_credentials ephemeral resource.
+// It reads the service URI and the admin credentials without storing them in the plan or state.
+package servicecredentials
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+
+	"github.com/aiven/terraform-provider-aiven/internal/plugin/adapter"
+	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
+)
+
+const typeName = "aiven_service_credentials"
+
+func idFields() []string {
+	return []string{"project", "service_name"}
+}
+
+func NewEphemeralResource() ephemeral.EphemeralResource {
+	return adapter.NewEphemeralResource(adapter.EphemeralResourceOptions{
+		TypeName:       typeName,
+		IDFields:       idFields(),
+		Schema:         ephemeralSchema,
+		SchemaInternal: ephemeralSchemaInternal(),
+		Open:           openServiceCredentials,
+	})
+}
+
+func openServiceCredentials(ctx context.Context, client avngen.Client, d adapter.ResourceData) error {
+	project := d.Get("project").(string)
+	serviceName := d.Get("service_name").(string)
+	s, err := client.ServiceGet(ctx, project, serviceName)
+	if err != nil {
+		return err
+	}
+
+	params := s.ServiceUriParams
+	port, _ := strconv.Atoi(uriParam(params, "port"))
+	username := uriParam(params, "user")
+	password := uriParam(params, "password")
+
+	// For some services, for example Kafka, URIParams does not provide the default user credentials
+	if username == "" || password == "" {
+		defaultUsername := schemautil.DefaultServiceUsername(s.ServiceType)
+		for _, u := range s.Users {
+			if u.Username == defaultUsername {
+				username = u.Username
+				password = u.Password
+			}
+		}
+	}
+
+	values := map[string]any{
+		"service_uri":      s.ServiceUri,
+		"service_host":     uriParam(params, "host"),
+		"service_port":     port,
+		"service_username": username,
+		"service_password": password,
+	}
+
+	for k, v := range values {
+		if err := d.Set(k, v); err != nil {
+			return err
+		}
+	}
+	return d.SetID(project, serviceName)
+}
+
+// uriParam returns the string value of the service URI parameter or an empty string if it is missing.
+func uriParam(params map[string]any, key string) string {
+	v, ok := params[key]
+	if !ok || v == nil {
+		return ""
+	}
+	return fmt.Sprint(v)
+}
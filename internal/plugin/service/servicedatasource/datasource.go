@@ -0,0 +1,47 @@
+// Package servicedatasource has the data sources of the service types, for instance, aiven_pg.
+//
+// The service resources are still SDK resources, the data sources keep their schemas and the read function,
+// see adapter.SDKDataSourceOptions. The schemas and the state of the data sources are the same.
+package servicedatasource
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/aiven/terraform-provider-aiven/internal/plugin/adapter"
+	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
+	"github.com/aiven/terraform-provider-aiven/internal/sdkprovider/service/clickhouse"
+	"github.com/aiven/terraform-provider-aiven/internal/sdkprovider/service/dragonfly"
+	"github.com/aiven/terraform-provider-aiven/internal/sdkprovider/service/flink"
+	"github.com/aiven/terraform-provider-aiven/internal/sdkprovider/service/grafana"
+	"github.com/aiven/terraform-provider-aiven/internal/sdkprovider/service/kafka"
+	"github.com/aiven/terraform-provider-aiven/internal/sdkprovider/service/mysql"
+	"github.com/aiven/terraform-provider-aiven/internal/sdkprovider/service/opensearch"
+	"github.com/aiven/terraform-provider-aiven/internal/sdkprovider/service/pg"
+	"github.com/aiven/terraform-provider-aiven/internal/sdkprovider/service/thanos"
+	"github.com/aiven/terraform-provider-aiven/internal/sdkprovider/service/valkey"
+)
+
+// DataSources returns the data sources of the service types.
+func DataSources() map[string]func() datasource.DataSource {
+	sdk := map[string]*schema.Resource{
+		"aiven_clickhouse":        clickhouse.DatasourceClickhouse(),
+		"aiven_dragonfly":         dragonfly.DatasourceDragonfly(),
+		"aiven_flink":             flink.DatasourceFlink(),
+		"aiven_grafana":           grafana.DatasourceGrafana(),
+		"aiven_kafka":             kafka.DatasourceKafka(),
+		"aiven_kafka_connect":     kafka.DatasourceKafkaConnect(),
+		"aiven_kafka_mirrormaker": kafka.DatasourceKafkaMirrormaker(),
+		"aiven_mysql":             mysql.DatasourceMySQL(),
+		"aiven_opensearch":        opensearch.DatasourceOpenSearch(),
+		"aiven_pg":                pg.DatasourcePG(),
+		"aiven_thanos":            thanos.DatasourceThanos(),
+		"aiven_valkey":            valkey.DatasourceValkey(),
+	}
+
+	result := make(map[string]func() datasource.DataSource, len(sdk))
+	for name, r := range sdk {
+		result[name] = adapter.NewLazyDataSource(adapter.SDKDataSourceOptions(name, r, schemautil.DatasourceServiceRead))
+	}
+	return result
+}
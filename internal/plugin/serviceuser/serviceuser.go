@@ -17,6 +17,7 @@ import (
 
 	"github.com/aiven/terraform-provider-aiven/internal/plugin/adapter"
 	"github.com/aiven/terraform-provider-aiven/internal/plugin/util"
+	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
 )
 
 // CreateUser expands the plan into a ServiceUserCreateIn (applying the given
@@ -44,6 +45,22 @@ func Create(ctx context.Context, client avngen.Client, d adapter.ResourceData, e
 	return ResetPassword(ctx, client, d)
 }
 
+// List returns the IDs of the service users, see adapter.ResourceOptions.List.
+func List(ctx context.Context, client avngen.Client, d adapter.ResourceData) ([]string, error) {
+	project := d.Get("project").(string)
+	serviceName := d.Get("service_name").(string)
+	s, err := client.ServiceGet(ctx, project, serviceName)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(s.Users))
+	for _, u := range s.Users {
+		ids = append(ids, schemautil.BuildResourceID(project, serviceName, u.Username))
+	}
+	return ids, nil
+}
+
 // ResetPassword resets a service user's password via a credentials reset.
 // A nil NewPassword tells the backend to auto-generate one.
 //
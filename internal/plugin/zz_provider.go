@@ -5,6 +5,8 @@ package plugin
 
 import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 
 	"github.com/aiven/terraform-provider-aiven/internal/plugin/adapter"
@@ -51,6 +53,7 @@ import (
 	user4 "github.com/aiven/terraform-provider-aiven/internal/plugin/service/pg/user"
 	"github.com/aiven/terraform-provider-aiven/internal/plugin/service/plan"
 	"github.com/aiven/terraform-provider-aiven/internal/plugin/service/planlist"
+	"github.com/aiven/terraform-provider-aiven/internal/plugin/service/servicebackups"
 	"github.com/aiven/terraform-provider-aiven/internal/plugin/service/servicelist"
 	"github.com/aiven/terraform-provider-aiven/internal/plugin/service/staticip"
 	"github.com/aiven/terraform-provider-aiven/internal/plugin/service/upgradepipeline/step"
@@ -145,9 +148,27 @@ func DataSources() map[string]func() datasource.DataSource {
 		"aiven_pg_database":                         adapter.NewLazyDataSource(database2.DataSourceOptions),
 		"aiven_pg_user":                             adapter.NewLazyDataSource(user4.DataSourceOptions),
 		"aiven_project_vpc":                         adapter.NewLazyDataSource(projectvpc.DataSourceOptions),
+		"aiven_service_backups":                     adapter.NewLazyDataSource(servicebackups.DataSourceOptions),
 		"aiven_service_list":                        adapter.NewLazyDataSource(servicelist.DataSourceOptions),
 		"aiven_service_plan":                        adapter.NewLazyDataSource(plan.DataSourceOptions),
 		"aiven_service_plan_list":                   adapter.NewLazyDataSource(planlist.DataSourceOptions),
 		"aiven_valkey_user":                         adapter.NewLazyDataSource(user5.DataSourceOptions),
 	}
 }
+
+func EphemeralResources() map[string]func() ephemeral.EphemeralResource {
+	return map[string]func() ephemeral.EphemeralResource{
+		"aiven_kafka_user_credentials":      adapter.NewLazyEphemeralResource(user1.EphemeralResourceOptions),
+		"aiven_mysql_user_credentials":      adapter.NewLazyEphemeralResource(user2.EphemeralResourceOptions),
+		"aiven_opensearch_user_credentials": adapter.NewLazyEphemeralResource(user3.EphemeralResourceOptions),
+		"aiven_pg_user_credentials":         adapter.NewLazyEphemeralResource(user4.EphemeralResourceOptions),
+	}
+}
+
+func ListResources() map[string]func() list.ListResource {
+	return map[string]func() list.ListResource{
+		"aiven_kafka_acl":   adapter.NewLazyListResource(acl.ResourceOptions),
+		"aiven_kafka_topic": adapter.NewLazyListResource(topic.ResourceOptions),
+		"aiven_pg_user":     adapter.NewLazyListResource(user4.ResourceOptions),
+	}
+}
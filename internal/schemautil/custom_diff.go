@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"slices"
 	"strings"
 
@@ -17,13 +18,26 @@ import (
 )
 
 func CustomizeDiffGenericService(serviceType string) schema.CustomizeDiffFunc {
-	diffs := []schema.CustomizeDiffFunc{
-		SetServiceTypeIfEmpty(serviceType),
+	diffs := append([]schema.CustomizeDiffFunc{SetServiceTypeIfEmpty(serviceType)}, serviceCustomizeDiffs()...)
+
+	// only add password WO validation for services that support it
+	if SupportsWriteOnlyPassword(serviceType) {
+		diffs = append(diffs, customizeDiffServicePasswordWo)
+	}
+
+	if SupportsRestoreFrom(serviceType) {
+		diffs = append(diffs, CustomizeDiffRestoreFrom)
+	}
+
+	return customdiff.Sequence(diffs...)
+}
+
+// serviceCustomizeDiffs returns the diffs of all service types
+func serviceCustomizeDiffs() []schema.CustomizeDiffFunc {
+	return []schema.CustomizeDiffFunc{
 		CustomizeDiffDisallowMultipleManyToOneKeys,
-		customdiff.IfValueChange("tag",
-			ShouldNotBeEmpty,
-			CustomizeDiffCheckUniqueTag,
-		),
+		CustomizeDiffCheckUniqueTag,
+		CustomizeDiffDefaultTags,
 		customdiff.IfValueChange("disk_space",
 			ShouldNotBeEmpty,
 			CustomizeDiffCheckDiskSpace,
@@ -43,19 +57,16 @@ func CustomizeDiffGenericService(serviceType string) schema.CustomizeDiffFunc {
 			CustomizeDiffCheckPlanAndStaticIpsCannotBeModifiedTogether,
 			CustomizeDiffCheckStaticIPDisassociation,
 		),
+		CustomizeDiffEstimatedMonthlyCost,
 	}
-
-	// only add password WO validation for services that support it
-	if supportsWriteOnlyPassword(serviceType) {
-		diffs = append(diffs,
-			CustomizeDiffServicePasswordWoVersion,
-			CustomizeDiffWriteOnlyPasswordTransitionWarning("service_password", "service_password_wo_version"),
-		)
-	}
-
-	return customdiff.Sequence(diffs...)
 }
 
+// customizeDiffServicePasswordWo validates the write-only password fields
+var customizeDiffServicePasswordWo = customdiff.Sequence(
+	CustomizeDiffServicePasswordWoVersion,
+	CustomizeDiffWriteOnlyPasswordTransitionWarning("service_password", "service_password_wo_version"),
+)
+
 // CustomizeDiffWriteOnlyPasswordTransitionWarning checks for a transition from plaintext
 // password field to write-only field (version 0 -> >0). If detected, it forces the password field to be re-computed.
 // This ensures the plan shows that the plaintext password is being cleared from the state,
@@ -99,20 +110,77 @@ func CustomizeDiffServiceIntegrationAfterCreation(_ context.Context, d *schema.R
 	return nil
 }
 
+// CustomizeDiffCheckUniqueTag checks the tags in the config.
+// The tag set in the plan can't have duplicates, because the provider default_tags are merged into it by key.
 func CustomizeDiffCheckUniqueTag(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	tags, _ := configTags(d)
 	t := make(map[string]bool)
-	for _, tag := range d.Get("tag").(*schema.Set).List() {
-		tagVal := tag.(map[string]any)
-		k := tagVal["key"].(string)
-		if t[k] {
-			return fmt.Errorf("tag keys should be unique, duplicate with the key: %s", k)
+	for _, tag := range tags {
+		if t[tag.key] {
+			return fmt.Errorf("tag keys should be unique, duplicate with the key: %s", tag.key)
 		}
-		t[k] = true
+		t[tag.key] = true
 	}
 
 	return nil
 }
 
+// CustomizeDiffDefaultTags merges the provider default_tags into the tag set, the tags in the config win.
+// The API returns the merged tags, so the state has no diff with the plan.
+func CustomizeDiffDefaultTags(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	defaults := common.DefaultTags()
+	tags, known := configTags(d)
+	if !known {
+		if len(defaults) == 0 {
+			return nil
+		}
+		// The tags are merged on apply, when the config is known
+		return d.SetNewComputed("tag")
+	}
+
+	configured := make(map[string]string, len(tags))
+	for _, tag := range tags {
+		configured[tag.key] = tag.value
+	}
+
+	merged := common.MergeDefaultTags(configured)
+	if maps.Equal(merged, GetTagsFromSchema(d)) {
+		return nil
+	}
+	return d.SetNew("tag", SetTagsTerraformProperties(merged))
+}
+
+type tagConfig struct {
+	key, value string
+}
+
+// configTags returns the tags from the config.
+// Returns false when some of the tags are unknown, for instance, refer to a resource that is not created yet.
+func configTags(d ResourceStateOrResourceDiff) ([]tagConfig, bool) {
+	c := d.GetRawConfig()
+	if c.IsNull() || !c.Type().HasAttribute("tag") {
+		return nil, true
+	}
+
+	v := c.GetAttr("tag")
+	if !v.IsWhollyKnown() {
+		return nil, false
+	}
+	if v.IsNull() {
+		return nil, true
+	}
+
+	tags := make([]tagConfig, 0, v.LengthInt())
+	for it := v.ElementIterator(); it.Next(); {
+		_, item := it.Element()
+		tags = append(tags, tagConfig{
+			key:   item.GetAttr("key").AsString(),
+			value: item.GetAttr("value").AsString(),
+		})
+	}
+	return tags, true
+}
+
 func CustomizeDiffCheckDiskSpace(ctx context.Context, d *schema.ResourceDiff, m any) error {
 	client, err := common.GenClient()
 	if err != nil {
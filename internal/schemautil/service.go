@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"regexp"
 	"slices"
@@ -94,37 +95,44 @@ func ServiceCommonSchemaWithUserConfig(kind string) map[string]*schema.Schema {
 	}
 
 	// add write-only password fields for supported services
-	if supportsWriteOnlyPassword(kind) {
-		s["service_password"].Description = "Password used for connecting to the service, if applicable. To avoid storing passwords in state, use service_password_wo instead if available. **WARNING:** When using write-only password field (service_password_wo), this field will be cleared from state and return an empty string. Any downstream resources or interpolations referencing this attribute will receive an empty value."
-
+	if SupportsWriteOnlyPassword(kind) {
 		// only PG, MySQL have admin_password in user_config
 		var conflictsWith []string
 		if slices.Contains([]string{ServiceTypePG, ServiceTypeMySQL}, kind) {
 			conflictsWith = []string{kind + "_user_config.0.admin_password"}
 		}
+		setWriteOnlyPasswordSchema(s, conflictsWith)
+	}
 
-		s["service_password_wo"] = &schema.Schema{
-			Type:          schema.TypeString,
-			Optional:      true,
-			Sensitive:     true,
-			WriteOnly:     true,
-			RequiredWith:  []string{"service_password_wo_version"},
-			ConflictsWith: conflictsWith,
-			ValidateFunc:  validation.StringLenBetween(8, 256),
-			Description:   "Password used for connecting to the service, if applicable (write-only, not stored in state). Must be used with service_password_wo_version. Cannot be empty. **WARNING:** Enabling this feature will clear service_password from state. Update any references to service_password in downstream resources before enabling.",
-		}
-		s["service_password_wo_version"] = &schema.Schema{
-			Type:         schema.TypeInt,
-			Optional:     true,
-			RequiredWith: []string{"service_password_wo"},
-			ValidateFunc: validation.IntAtLeast(1),
-			Description:  "Version number for service_password_wo. Increment this to rotate the password. Must be >= 1. When transitioning from auto-generated passwords (version 0), the service_password field will be cleared from state.",
-		}
+	if SupportsRestoreFrom(kind) {
+		s["restore_from"] = restoreFromSchema(kind)
 	}
 
 	return s
 }
 
+// setWriteOnlyPasswordSchema adds the write-only password fields
+func setWriteOnlyPasswordSchema(s map[string]*schema.Schema, conflictsWith []string) {
+	s["service_password"].Description = "Password used for connecting to the service, if applicable. To avoid storing passwords in state, use service_password_wo instead if available. **WARNING:** When using write-only password field (service_password_wo), this field will be cleared from state and return an empty string. Any downstream resources or interpolations referencing this attribute will receive an empty value."
+	s["service_password_wo"] = &schema.Schema{
+		Type:          schema.TypeString,
+		Optional:      true,
+		Sensitive:     true,
+		WriteOnly:     true,
+		RequiredWith:  []string{"service_password_wo_version"},
+		ConflictsWith: conflictsWith,
+		ValidateFunc:  validation.StringLenBetween(8, 256),
+		Description:   "Password used for connecting to the service, if applicable (write-only, not stored in state). Must be used with service_password_wo_version. Cannot be empty. **WARNING:** Enabling this feature will clear service_password from state. Update any references to service_password in downstream resources before enabling.",
+	}
+	s["service_password_wo_version"] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		RequiredWith: []string{"service_password_wo"},
+		ValidateFunc: validation.IntAtLeast(1),
+		Description:  "Version number for service_password_wo. Increment this to rotate the password. Must be >= 1. When transitioning from auto-generated passwords (version 0), the service_password field will be cleared from state.",
+	}
+}
+
 // getBootstrapIntegrationTypes returns the integration types that are allowed to be set when creating a service.
 func getBootstrapIntegrationTypes(kind string) []service.IntegrationType {
 	list := make([]service.IntegrationType, 0)
@@ -141,12 +149,17 @@ func getBootstrapIntegrationTypes(kind string) []service.IntegrationType {
 	return list
 }
 
-// supportsWriteOnlyPassword returns true if the service type supports write-only password management
+// SupportsWriteOnlyPassword returns true if the service type supports write-only password management
 // Some services may not support password rotation, or has not been implemented yet.
-// This function can be extended in the future to include more services.
-func supportsWriteOnlyPassword(serviceType string) bool {
-	// empty list for now, will be extended in the future PRs
-	supportedServices := []string{}
+func SupportsWriteOnlyPassword(serviceType string) bool {
+	supportedServices := []string{
+		ServiceTypePG,
+		ServiceTypeMySQL,
+		ServiceTypeValkey,
+		ServiceTypeOpenSearch,
+		ServiceTypeClickhouse,
+		ServiceTypeKafka,
+	}
 
 	return slices.Contains(supportedServices, serviceType)
 }
@@ -290,6 +303,11 @@ func ServiceCommonSchema() map[string]*schema.Schema {
 			Computed:    true,
 			Description: "The maximum disk space of the service, possible values depend on the service type, the cloud provider and the project.",
 		},
+		"estimated_monthly_cost": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The estimated monthly cost of the service in USD: the `plan` price and the additional disk space in the cloud region. Doesn't include discounts, credits, taxes and usage-based charges, for instance, object storage. Empty for plans without a price, for instance, custom plans. The provider `cost_warning_threshold` shows a warning in the plan when the cost increases.",
+		},
 		"service_uri": {
 			Type:        schema.TypeString,
 			Computed:    true,
@@ -320,7 +338,33 @@ func ServiceCommonSchema() map[string]*schema.Schema {
 		"state": {
 			Type:        schema.TypeString,
 			Computed:    true,
-			Description: "Service state. Possible values are `POWEROFF`, `REBALANCING`, `REBUILDING` or `RUNNING`. Services cannot be powered on or off with Terraform. To power a service on or off, [use the Aiven Console or Aiven CLI](https://aiven.io/docs/platform/concepts/service-power-cycle).",
+			Description: "Service state. Possible values are `POWEROFF`, `REBALANCING`, `REBUILDING` or `RUNNING`.",
+		},
+		"powered": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+			Description: "Powers the service on or off. When not set, the power state is not managed by Terraform. Powering off a service [deletes the data that is not backed up](https://aiven.io/docs/platform/concepts/service-power-cycle). Databases, users and other resources of a powered off service are not refreshed.",
+		},
+		"readiness": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: "Configures when the service is considered ready after it is created or updated.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"mode": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						Default:      ReadinessModeDefault,
+						ValidateFunc: validation.StringInSlice(readinessModes, false),
+						Description: userconfig.Desc("The `fast` mode waits until the service is `RUNNING`. The `default` mode also waits for the backups, the Grafana endpoint and the static IPs. The `strict` mode also waits until all nodes are running, the public components are resolvable in DNS and Kafka rebalancing is finished").
+							PossibleValuesString(readinessModes...).
+							DefaultValue(ReadinessModeDefault).
+							Build(),
+					},
+				},
+			},
 		},
 		"service_integrations": {
 			Type:        schema.TypeSet,
@@ -410,10 +454,98 @@ func ServiceCommonSchema() map[string]*schema.Schema {
 				},
 			},
 		},
+		"node_states": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "State of the service nodes. The progress updates show the progress of rebalancing and migrations, for instance, during plan and disk space changes.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Name of the node",
+					},
+					"role": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Role of the node, for instance, `master`, `standby` or `read-replica`",
+					},
+					"state": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "State of the node, for instance, `running`, `setting_up_vm`, `syncing_data` or `leaving`",
+					},
+					"progress_updates": {
+						Type:        schema.TypeList,
+						Computed:    true,
+						Description: "Progress of the node operations",
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"phase": {
+									Type:        schema.TypeString,
+									Computed:    true,
+									Description: "Name of the operation phase, for instance, `prepare`, `basebackup`, `stream` or `finalize`",
+								},
+								"completed": {
+									Type:        schema.TypeBool,
+									Computed:    true,
+									Description: "Whether the phase is completed",
+								},
+								"current": {
+									Type:        schema.TypeInt,
+									Computed:    true,
+									Description: "Current progress of the phase",
+								},
+								"min": {
+									Type:        schema.TypeInt,
+									Computed:    true,
+									Description: "Minimum value of the progress",
+								},
+								"max": {
+									Type:        schema.TypeInt,
+									Computed:    true,
+									Description: "Maximum value of the progress",
+								},
+								"unit": {
+									Type:        schema.TypeString,
+									Computed:    true,
+									Description: "Unit of the progress, for instance, `bytes_compressed`, `bytes_uncompressed` or `partitions_to_transfer`",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"pending_maintenance_updates": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "The updates that are applied in the next maintenance. Use the `aiven_service_maintenance` resource to apply them before the maintenance window.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"description": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Description of the update",
+					},
+					"deadline": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The time when the update is applied in the maintenance window, if it isn't applied before",
+					},
+					"impact": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Impact of the update on the service, for instance, a restart of the nodes",
+					},
+				},
+			},
+		},
 		"tag": {
-			Description: "Tags are key-value pairs that allow you to categorize services.",
+			Description: "Tags are key-value pairs that allow you to categorize services. The provider `default_tags` are added to them.",
 			Type:        schema.TypeSet,
 			Optional:    true,
+			Computed:    true, // has the provider default_tags, see CustomizeDiffDefaultTags
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					"key": {
@@ -447,6 +579,10 @@ func ResourceServiceCreateWrapper(serviceType string) schema.CreateContextFunc {
 	})
 }
 
+func ResourceServiceCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	return common.WithGenClientDiag(resourceServiceCreate)(ctx, d, m)
+}
+
 func ResourceServiceRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	return common.WithGenClientDiag(resourceServiceRead)(ctx, d, m)
 }
@@ -460,6 +596,20 @@ func ResourceServiceDelete(ctx context.Context, d *schema.ResourceData, m any) d
 }
 
 func resourceServiceRead(ctx context.Context, d *schema.ResourceData, client avngen.Client) diag.Diagnostics {
+	diags := readService(ctx, d, client)
+	if diags.HasError() {
+		return diags
+	}
+
+	if timeoutWarning := common.CheckDeprecatedTimeoutDefault(d); timeoutWarning.Severity != 0 {
+		diags = append(diags, timeoutWarning)
+	}
+
+	return diags
+}
+
+// readService reads the service into the resource or the data source.
+func readService(ctx context.Context, d ResourceData, client avngen.Client) diag.Diagnostics {
 	projectName, serviceName, err := SplitResourceID2(d.Id())
 	if err != nil {
 		return diag.Errorf("error splitting service ID: %s", err)
@@ -484,11 +634,30 @@ func resourceServiceRead(ctx context.Context, d *schema.ResourceData, client avn
 		return diag.Errorf("unable to get service plan parameters: %s", err)
 	}
 
+	costInputs := estimatedCostInputs(d)
 	err = copyServicePropertiesFromAPIResponseToTerraform(d, s, servicePlanParams, projectName)
 	if err != nil {
 		return diag.Errorf("unable to copy api response into terraform schema: %s", err)
 	}
 
+	var diags diag.Diagnostics
+	if d.Get("estimated_monthly_cost").(string) == "" || costInputs != estimatedCostInputs(d) {
+		additionalDiskSpace := 0
+		if !servicePlanParams.IsEmpty() {
+			additionalDiskSpace = max(lo.FromPtr(s.DiskSpaceMb)-servicePlanParams.DiskSizeMBDefault, 0)
+		}
+
+		// The estimate is informational, it doesn't fail the read
+		err = setEstimatedMonthlyCost(ctx, client, d, projectName, s.ServiceType, s.Plan, s.CloudName, additionalDiskSpace)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Unable to estimate the monthly cost",
+				Detail:   err.Error(),
+			})
+		}
+	}
+
 	serviceIps, err := ServiceStaticIpsList(ctx, client, projectName, serviceName)
 	if err != nil {
 		return diag.Errorf("unable to currently allocated static ips: %s", err)
@@ -510,7 +679,6 @@ func resourceServiceRead(ctx context.Context, d *schema.ResourceData, client avn
 		return diag.Errorf("unable to set tech_emails in schema: %s", err)
 	}
 
-	var diags diag.Diagnostics
 	for _, v := range s.ServiceNotifications {
 		if v.Type == service.ServiceNotificationTypeServiceEndOfLife {
 			const detail = "See the [documentation](%s) for more information on end of life for Aiven services."
@@ -522,10 +690,6 @@ func resourceServiceRead(ctx context.Context, d *schema.ResourceData, client avn
 		}
 	}
 
-	if timeoutWarning := common.CheckDeprecatedTimeoutDefault(d); timeoutWarning.Severity != 0 {
-		diags = append(diags, timeoutWarning)
-	}
-
 	return diags
 }
 
@@ -548,6 +712,10 @@ func resourceServiceCreate(ctx context.Context, d *schema.ResourceData, client a
 		return diag.FromErr(err)
 	}
 
+	if err := setRestoreFromUserConfig(d, cuc); err != nil {
+		return diag.FromErr(err)
+	}
+
 	technicalEmails, err := getContactEmailListForAPI(d)
 	if err != nil {
 		return diag.FromErr(err)
@@ -600,8 +768,16 @@ func resourceServiceCreate(ctx context.Context, d *schema.ResourceData, client a
 		return diag.Errorf("error setting service tags: %s", err)
 	}
 
+	// Sets the ID first, so a failed power off doesn't leave the created service out of the state
 	d.SetId(BuildResourceID(project, s.ServiceName))
 
+	// The service is always created powered on
+	if powered := configuredPowerState(d); powered != nil && !*powered {
+		if err := powerService(ctx, d, client, *powered); err != nil {
+			return diag.Errorf("error powering off service %s/%s: %s", project, s.ServiceName, err)
+		}
+	}
+
 	return ResourceServiceRead(ctx, d, client)
 }
 
@@ -650,7 +826,7 @@ func resourceServiceUpdate(ctx context.Context, d *schema.ResourceData, client a
 
 	cloud := d.Get("cloud_name").(string)
 	plan := d.Get("plan").(string)
-	powered := true
+	powered := configuredPowerState(d)
 	terminationProtection := d.Get("termination_protection").(bool)
 
 	// Sends disk size only when there is no autoscaler enabled
@@ -676,7 +852,7 @@ func resourceServiceUpdate(ctx context.Context, d *schema.ResourceData, client a
 		Plan:                  &plan,
 		Maintenance:           GetMaintenanceWindow(d),
 		ProjectVpcId:          vpcID,
-		Powered:               &powered,
+		Powered:               powered,
 		TerminationProtection: &terminationProtection,
 		DiskSpaceMb:           diskSpaceMb,
 		Karapace:              karapace,
@@ -689,8 +865,22 @@ func resourceServiceUpdate(ctx context.Context, d *schema.ResourceData, client a
 		return diag.Errorf("error updating (%s) service: %s", serviceName, err)
 	}
 
-	if _, err = WaitForServiceUpdate(ctx, d, client); err != nil {
-		return diag.Errorf("error waiting for service (%s) update: %s", serviceName, err)
+	if powered != nil && *powered != (s.State != service.ServiceStateTypePoweroff) {
+		if _, err = WaitForServicePowerState(ctx, d, client, *powered); err != nil {
+			return diag.Errorf("error waiting for service (%s) power state change: %s", serviceName, err)
+		}
+	}
+
+	// A powered off service has no backups or reachable hosts to wait for,
+	// when "powered" is not set, the service keeps its power state
+	poweredOff := s.State == service.ServiceStateTypePoweroff
+	if powered != nil {
+		poweredOff = !*powered
+	}
+	if !poweredOff {
+		if _, err = WaitForServiceUpdate(ctx, d, client); err != nil {
+			return diag.Errorf("error waiting for service (%s) update: %s", serviceName, err)
+		}
 	}
 
 	username := adminUsername(s, serviceType)
@@ -819,6 +1009,9 @@ func copyServicePropertiesFromAPIResponseToTerraform(
 	if err := d.Set("state", s.State); err != nil {
 		return err
 	}
+	if err := d.Set("powered", s.State != service.ServiceStateTypePoweroff); err != nil {
+		return err
+	}
 	if err := d.Set("plan", s.Plan); err != nil {
 		return err
 	}
@@ -890,6 +1083,7 @@ func copyServicePropertiesFromAPIResponseToTerraform(
 		}
 	}
 
+	removeRestoreFromUserConfig(d, s.UserConfig)
 	err := FlattenService(serviceType, d, s.UserConfig)
 	if err != nil {
 		return err
@@ -921,8 +1115,9 @@ func copyServicePropertiesFromAPIResponseToTerraform(
 
 	// for some services, for example Kafka URIParams does not provide default user credentials
 	if !passwordOK || !usernameOK {
+		admin := adminUsername(s, serviceType)
 		for _, u := range s.Users {
-			if u.Username == "avnadmin" {
+			if u.Username == admin {
 				if err := d.Set("service_username", u.Username); err != nil {
 					return err
 				}
@@ -937,6 +1132,24 @@ func copyServicePropertiesFromAPIResponseToTerraform(
 		return fmt.Errorf("cannot set `components` : %w", err)
 	}
 
+	nodeStates, err := FlattenServiceNodeStates(s)
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("node_states", nodeStates); err != nil {
+		return fmt.Errorf("cannot set `node_states` : %w", err)
+	}
+
+	maintenanceUpdates, err := FlattenServiceMaintenanceUpdates(s)
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("pending_maintenance_updates", maintenanceUpdates); err != nil {
+		return fmt.Errorf("cannot set `pending_maintenance_updates` : %w", err)
+	}
+
 	// Handle service integrations
 	integrations := flattenIntegrations(s.ServiceIntegrations, getBootstrapIntegrationTypes(serviceType)...)
 	if err := d.Set("service_integrations", integrations); err != nil {
@@ -970,6 +1183,87 @@ func FlattenServiceComponents(r *service.ServiceGetOut) []map[string]any {
 	return components
 }
 
+// nodeState is the state of a service node, the API returns typed enums, so it is read as strings
+type nodeState struct {
+	Name            string           `json:"name"`
+	Role            string           `json:"role"`
+	State           string           `json:"state"`
+	ProgressUpdates []progressUpdate `json:"progress_updates"`
+}
+
+type progressUpdate struct {
+	Phase     string `json:"phase"`
+	Completed bool   `json:"completed"`
+	Current   int    `json:"current"`
+	Min       int    `json:"min"`
+	Max       int    `json:"max"`
+	Unit      string `json:"unit"`
+}
+
+func getNodeStates(r *service.ServiceGetOut) ([]nodeState, error) {
+	var nodes []nodeState
+	if err := Remarshal(r.NodeStates, &nodes); err != nil {
+		return nil, fmt.Errorf("cannot read node states: %w", err)
+	}
+	return nodes, nil
+}
+
+func FlattenServiceNodeStates(r *service.ServiceGetOut) ([]map[string]any, error) {
+	nodes, err := getNodeStates(r)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]map[string]any, len(nodes))
+	for i, n := range nodes {
+		updates := make([]map[string]any, len(n.ProgressUpdates))
+		for j, u := range n.ProgressUpdates {
+			updates[j] = map[string]any{
+				"phase":     u.Phase,
+				"completed": u.Completed,
+				"current":   u.Current,
+				"min":       u.Min,
+				"max":       u.Max,
+				"unit":      u.Unit,
+			}
+		}
+
+		result[i] = map[string]any{
+			"name":             n.Name,
+			"role":             n.Role,
+			"state":            n.State,
+			"progress_updates": updates,
+		}
+	}
+	return result, nil
+}
+
+// maintenanceUpdate is a pending maintenance update, the API doesn't always return all fields
+type maintenanceUpdate struct {
+	Description string `json:"description"`
+	Deadline    string `json:"deadline"`
+	Impact      string `json:"impact"`
+}
+
+func FlattenServiceMaintenanceUpdates(r *service.ServiceGetOut) ([]map[string]any, error) {
+	var maintenance struct {
+		Updates []maintenanceUpdate `json:"updates"`
+	}
+	if err := Remarshal(r.Maintenance, &maintenance); err != nil {
+		return nil, fmt.Errorf("cannot read maintenance updates: %w", err)
+	}
+
+	result := make([]map[string]any, len(maintenance.Updates))
+	for i, u := range maintenance.Updates {
+		result[i] = map[string]any{
+			"description": u.Description,
+			"deadline":    u.Deadline,
+			"impact":      u.Impact,
+		}
+	}
+	return result, nil
+}
+
 // TODO: This uses an untyped map in the final resource's schema, which might be unclear to the end users.
 //
 //	We should change this in the next major version.
@@ -979,6 +1273,11 @@ func copyConnectionInfoFromAPIResponseToTerraform(
 	connectionInfo *service.ConnectionInfoOut,
 	metadata map[string]any,
 ) error {
+	if isGenericService(d) {
+		// Doesn't have the connection info blocks of the typed resources
+		return nil
+	}
+
 	props := make(map[string]any)
 
 	switch serviceType {
@@ -1105,7 +1404,7 @@ func copyConnectionInfoFromAPIResponseToTerraform(
 func setServicePassword(d ResourceData, password any) error {
 	serviceType := d.Get("service_type").(string)
 
-	if supportsWriteOnlyPassword(serviceType) {
+	if SupportsWriteOnlyPassword(serviceType) {
 		// clear the plain text password field when using write-only passwords
 		if version, ok := d.GetOk("service_password_wo_version"); ok && version.(int) > 0 {
 			return d.Set("service_password", "")
@@ -1115,6 +1414,9 @@ func setServicePassword(d ResourceData, password any) error {
 	return d.Set("service_password", password)
 }
 
+// userTypePrimary is the type of the service admin user
+const userTypePrimary = "primary"
+
 // DefaultServiceUsername returns the default admin username managed by Aiven for a given service type.
 // Different services use different default usernames (e.g., avnadmin, default, etc.).
 func DefaultServiceUsername(serviceType string) string {
@@ -1127,13 +1429,21 @@ func DefaultServiceUsername(serviceType string) string {
 }
 
 // adminUsername resolves the admin username from the service state or falls back to default
-// some services may override the default admin username via configuration during creation
+// some services may override the default admin username via configuration during creation,
+// for instance, pg_user_config.admin_username
 func adminUsername(s *service.ServiceGetOut, serviceType string) string {
 	// check ServiceUriParams for the actual username
 	if username, ok := s.ServiceUriParams["user"]; ok && username != "" {
 		return fmt.Sprintf("%v", username)
 	}
 
+	// Kafka and OpenSearch have no user in ServiceUriParams, the admin is the primary user
+	for _, u := range s.Users {
+		if u.Type == userTypePrimary {
+			return u.Username
+		}
+	}
+
 	// fall back to service type default
 	return DefaultServiceUsername(serviceType)
 }
@@ -1145,7 +1455,7 @@ func upsertServicePassword(ctx context.Context, d ResourceData, client avngen.Cl
 	serviceType := d.Get("service_type").(string)
 
 	// only process if this service type supports write-only passwords
-	if !supportsWriteOnlyPassword(serviceType) {
+	if !SupportsWriteOnlyPassword(serviceType) {
 		return nil
 	}
 
@@ -1189,6 +1499,11 @@ func upsertServicePassword(ctx context.Context, d ResourceData, client avngen.Cl
 	return nil
 }
 
+// CustomizeDiffWriteOnlyVersion ensures that the version field of a write-only field only increases
+func CustomizeDiffWriteOnlyVersion(fieldName string) schema.CustomizeDiffFunc {
+	return customizeDiffPasswordWoVersion(fieldName)
+}
+
 // customizeDiffPasswordWoVersion is a helper that ensures a password write-only version field only increases.
 // Allows removal of write-only password by setting version to 0.
 // This enforces the policy that write-only passwords can only be rotated forward and follow the same UX as other providers.
@@ -1250,16 +1565,14 @@ func ResourceReadHandleNotFound(err error, d ResourceData) error {
 	return err
 }
 
-func DatasourceServiceRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
-	return common.WithGenClientDiag(datasourceServiceRead)(ctx, d, m)
-}
-
-func datasourceServiceRead(ctx context.Context, d *schema.ResourceData, client avngen.Client) diag.Diagnostics {
+// DatasourceServiceRead reads the service data sources, for instance, aiven_pg.
+// They are served by the plugin framework, see adapter.SDKDataSourceOptions.
+func DatasourceServiceRead(ctx context.Context, client avngen.Client, d ResourceData) diag.Diagnostics {
 	projectName := d.Get("project").(string)
 	serviceName := d.Get("service_name").(string)
 	d.SetId(BuildResourceID(projectName, serviceName))
 
-	diags := ResourceServiceRead(ctx, d, client)
+	diags := readService(ctx, d, client)
 	if diags.HasError() {
 		return diags
 	}
@@ -1284,10 +1597,16 @@ func getContactEmailListForAPI(d ResourceData) (*[]service.TechEmailIn, error) {
 }
 
 func ExpandService(name string, d ResourceData) (map[string]any, error) {
+	if isGenericService(d) {
+		return expandUserConfigJSON(d)
+	}
 	return converters.Expand(converters.ServiceUserConfig, name, d)
 }
 
 func FlattenService(name string, d ResourceData, dto map[string]any) error {
+	if isGenericService(d) {
+		return flattenUserConfigJSON(d, dto)
+	}
 	return converters.Flatten(converters.ServiceUserConfig, name, d, dto)
 }
 
@@ -1308,6 +1627,26 @@ func ContainsRedactedCreds(config map[string]any) error {
 	return nil
 }
 
+// configuredPowerState returns the power state from the config, or nil when it is not managed by Terraform.
+func configuredPowerState(d ResourceData) *bool {
+	if !HasConfigValue(d, "powered") {
+		return nil
+	}
+	return lo.ToPtr(d.Get("powered").(bool))
+}
+
+// powerService powers the service on or off and waits for the new state.
+func powerService(ctx context.Context, d ResourceData, client avngen.Client, powered bool) error {
+	project, serviceName := d.Get("project").(string), d.Get("service_name").(string)
+	_, err := client.ServiceUpdate(ctx, project, serviceName, &service.ServiceUpdateIn{Powered: &powered})
+	if err != nil {
+		return err
+	}
+
+	_, err = WaitForServicePowerState(ctx, d, client, powered)
+	return err
+}
+
 var (
 	servicePoweredOff    DoOnce[bool]
 	ErrServicePoweredOff = fmt.Errorf("the service is powered off")
@@ -1318,6 +1657,16 @@ func ServicePoweredOffForget(project, serviceName string) {
 	servicePoweredOff.Forget(project, serviceName)
 }
 
+// IsServicePoweredOff returns true when the err is caused by the powered off service.
+// The resources of a powered off service can't be read, for instance, databases, users and topics.
+// In that case, they keep the previous state instead of failing the refresh.
+func IsServicePoweredOff(ctx context.Context, client avngen.Client, project, serviceName string, err error) bool {
+	if errors.Is(err, ErrServicePoweredOff) {
+		return true
+	}
+	return errors.Is(CheckServiceIsPowered(ctx, client, project, serviceName), ErrServicePoweredOff)
+}
+
 // CheckServiceIsPowered checks if a service is powered on before performing operations that require it.
 // Some operations like database management require the service to be running.
 // For example, `ServiceDatabaseList` returns a generic 503 error when the service is powered off:
@@ -0,0 +1,125 @@
+package schemautil
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"github.com/aiven/go-client-codegen/handler/project"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/samber/lo"
+
+	"github.com/aiven/terraform-provider-aiven/internal/common"
+)
+
+// hoursPerMonth is the number of hours in a month the Aiven prices are calculated with
+const hoursPerMonth = 730
+
+// estimatedCostFields change the estimated monthly cost
+var estimatedCostFields = []string{"project", "plan", "cloud_name", "disk_space", "additional_disk_space"}
+
+// estimateMonthlyCost returns the estimated monthly cost in USD of the plan and the additional disk space
+func estimateMonthlyCost(ctx context.Context, client avngen.Client, projectName, serviceType, plan, cloudName string, additionalDiskSpaceMB int) (float64, error) {
+	price, err := client.ProjectServicePlanPriceGet(ctx, projectName, serviceType, plan, cloudName)
+	if err != nil {
+		return 0, err
+	}
+	return monthlyCost(price, additionalDiskSpaceMB)
+}
+
+// monthlyCost returns the monthly cost of the hourly prices
+func monthlyCost(price *project.ProjectServicePlanPriceGetOut, additionalDiskSpaceMB int) (float64, error) {
+	hourly, err := strconv.ParseFloat(price.BasePriceUsd, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid base price %q: %w", price.BasePriceUsd, err)
+	}
+
+	if additionalDiskSpaceMB > 0 {
+		v := lo.FromPtr(price.ExtraDiskPricePerGbUsd)
+		diskPrice, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid additional disk space price %q: %w", v, err)
+		}
+		hourly += diskPrice * float64(additionalDiskSpaceMB) / 1024
+	}
+	return hourly * hoursPerMonth, nil
+}
+
+func formatCost(v float64) string {
+	return strconv.FormatFloat(v, 'f', 2, 64)
+}
+
+// estimatedCostInputs returns the service fields read from the API that change the estimated monthly cost.
+// The read estimates the cost again only when they change.
+func estimatedCostInputs(d ResourceData) string {
+	return fmt.Sprintf("%v/%v/%v", d.Get("plan"), d.Get("cloud_name"), d.Get("disk_space_used"))
+}
+
+// setEstimatedMonthlyCost sets the estimated_monthly_cost of the service read from the API.
+// Plans without a price in the cloud, for instance, custom plans, have no estimate.
+func setEstimatedMonthlyCost(ctx context.Context, client avngen.Client, d ResourceData, projectName, serviceType, plan, cloudName string, additionalDiskSpaceMB int) error {
+	cost, err := estimateMonthlyCost(ctx, client, projectName, serviceType, plan, cloudName, additionalDiskSpaceMB)
+	switch {
+	case avngen.IsNotFound(err):
+		return d.Set("estimated_monthly_cost", "")
+	case err != nil:
+		return fmt.Errorf("unable to estimate the monthly cost: %w", err)
+	}
+	return d.Set("estimated_monthly_cost", formatCost(cost))
+}
+
+// CustomizeDiffEstimatedMonthlyCost shows the new estimated_monthly_cost in the plan,
+// and adds a warning when the cost increases by more than the provider cost_warning_threshold.
+func CustomizeDiffEstimatedMonthlyCost(ctx context.Context, d *schema.ResourceDiff, _ any) error {
+	if d.Id() != "" && !d.HasChanges(estimatedCostFields...) {
+		return nil
+	}
+
+	for _, k := range estimatedCostFields {
+		if !d.NewValueKnown(k) {
+			return d.SetNewComputed("estimated_monthly_cost")
+		}
+	}
+
+	client, err := common.GenClient()
+	if err != nil {
+		return err
+	}
+
+	plan, err := GetServicePlanParametersFromSchema(ctx, client, d)
+	if err != nil {
+		return fmt.Errorf("unable to get service plan parameters: %w", err)
+	}
+
+	diskSpace, err := getDiskSpaceFromStateOrDiff(ctx, d, client)
+	if err != nil {
+		return err
+	}
+
+	projectName := d.Get("project").(string)
+	serviceType := d.Get("service_type").(string)
+	servicePlan := d.Get("plan").(string)
+	cost, err := estimateMonthlyCost(ctx, client, projectName, serviceType, servicePlan, d.Get("cloud_name").(string), diskSpace-plan.DiskSizeMBDefault)
+	if avngen.IsNotFound(err) {
+		return d.SetNew("estimated_monthly_cost", "")
+	}
+	if err != nil {
+		return fmt.Errorf("unable to estimate the monthly cost: %w", err)
+	}
+
+	// Zero when the service is created or had no estimate
+	oldCost, _ := strconv.ParseFloat(d.Get("estimated_monthly_cost").(string), 64)
+	if threshold, ok := common.CostWarningThreshold(); ok && cost-oldCost > threshold {
+		common.AddPlanWarning(
+			ctx,
+			"Estimated monthly cost increase",
+			fmt.Sprintf(
+				"The estimated monthly cost of the service %s/%s increases by %s USD, from %s to %s USD, "+
+					"which exceeds the provider cost_warning_threshold of %s USD.",
+				projectName, d.Get("service_name").(string), formatCost(cost-oldCost), formatCost(oldCost), formatCost(cost), formatCost(threshold),
+			),
+		)
+	}
+	return d.SetNew("estimated_monthly_cost", formatCost(cost))
+}
@@ -0,0 +1,186 @@
+package schemautil
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/aiven/terraform-provider-aiven/internal/schemautil/userconfig"
+	"github.com/aiven/terraform-provider-aiven/internal/sdkprovider/userconfig/apischema"
+	"github.com/aiven/terraform-provider-aiven/internal/sdkprovider/userconfig/converters"
+)
+
+// userConfigJSONKey is the JSON-encoded user config of the aiven_service resource,
+// which replaces the typed <service_type>_user_config blocks
+const userConfigJSONKey = "user_config"
+
+// userConfigCreateOnlyFields are received on POST request only, so they are kept from the state
+var userConfigCreateOnlyFields = []string{"admin_username", "admin_password"}
+
+// isGenericService returns true for the aiven_service resource
+func isGenericService(d ResourceStateOrResourceDiff) bool {
+	_, ok := d.Get(userConfigJSONKey).(string)
+	return ok
+}
+
+// ServiceGenericSchema returns the schema of the aiven_service resource that manages any service type
+func ServiceGenericSchema() map[string]*schema.Schema {
+	s := ServiceCommonSchema()
+	s["service_type"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.StringInSlice(apischema.ServiceTypes(), false),
+		Description:  userconfig.Desc("The service type, for example, `pg` or `kafka`.").ForceNew().Build(),
+	}
+	s[userConfigJSONKey] = &schema.Schema{
+		Type:             schema.TypeString,
+		Optional:         true,
+		Sensitive:        true,
+		ValidateFunc:     validation.StringIsJSON,
+		DiffSuppressFunc: structure.SuppressJsonDiff,
+		Description: "The JSON-encoded service configuration, the same options as in the `<service_type>_user_config` block " +
+			"of the resource of the service type, with the API field names. For example, `jsonencode({ pg_version = \"16\" })`. " +
+			"Validated against the configuration schema of the `service_type` when planning. " +
+			"Only the options set in this field are managed, options that you add cannot be removed later.",
+	}
+	setWriteOnlyPasswordSchema(s, nil)
+	return s
+}
+
+// CustomizeDiffServiceAnyType is the CustomizeDiff of the aiven_service resource
+func CustomizeDiffServiceAnyType() schema.CustomizeDiffFunc {
+	diffs := append(serviceCustomizeDiffs(), CustomizeDiffUserConfigJSON, customizeDiffServicePasswordWo)
+	return customdiff.Sequence(diffs...)
+}
+
+// CustomizeDiffUserConfigJSON validates the user config and the fields that depend on the service type
+func CustomizeDiffUserConfigJSON(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	if !d.NewValueKnown("service_type") {
+		return nil
+	}
+
+	serviceType := d.Get("service_type").(string)
+	if !SupportsWriteOnlyPassword(serviceType) && !d.GetRawConfig().GetAttr("service_password_wo").IsNull() {
+		return fmt.Errorf("service_password_wo is not supported by the %s service type", serviceType)
+	}
+
+	integrations := FlattenToString(getBootstrapIntegrationTypes(serviceType))
+	for _, v := range GetAPIServiceIntegrations(d) {
+		if !slices.Contains(integrations, string(v.IntegrationType)) {
+			return fmt.Errorf("service integration %s can't be specified for the %s service type", v.IntegrationType, serviceType)
+		}
+	}
+
+	if !d.NewValueKnown(userConfigJSONKey) {
+		return nil
+	}
+
+	userConfig, err := parseUserConfigJSON(d.Get(userConfigJSONKey).(string))
+	if err != nil {
+		return err
+	}
+
+	if err := apischema.ValidateServiceUserConfig(serviceType, userConfig); err != nil {
+		return fmt.Errorf("invalid user_config for the %s service type:\n%w", serviceType, err)
+	}
+	return nil
+}
+
+// parseUserConfigJSON returns the user config, numbers are json.Number to keep big integers
+func parseUserConfigJSON(s string) (map[string]any, error) {
+	m := make(map[string]any)
+	if s == "" {
+		return m, nil
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(s))
+	decoder.UseNumber()
+	if err := decoder.Decode(&m); err != nil {
+		return nil, fmt.Errorf("user_config must be a JSON object: %w", err)
+	}
+	return m, nil
+}
+
+// expandUserConfigJSON returns the user config of the aiven_service resource for the API request
+func expandUserConfigJSON(d ResourceData) (map[string]any, error) {
+	return parseUserConfigJSON(d.Get(userConfigJSONKey).(string))
+}
+
+// flattenUserConfigJSON sets the options of the user config that are in the state.
+// The API returns the default values too, which would show a diff.
+func flattenUserConfigJSON(d ResourceData, dto map[string]any) error {
+	known, err := parseUserConfigJSON(d.Get(userConfigJSONKey).(string))
+	if err != nil {
+		return err
+	}
+
+	if len(known) == 0 {
+		return nil
+	}
+
+	result := filterUserConfig(dto, known)
+	for _, k := range userConfigCreateOnlyFields {
+		if _, ok := result[k]; !ok && known[k] != nil {
+			result[k] = known[k]
+		}
+	}
+
+	b, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+	return d.Set(userConfigJSONKey, string(b))
+}
+
+// filterUserConfig returns the options of the dto that are in the known options, goes into nested objects
+func filterUserConfig(dto, known map[string]any) map[string]any {
+	result := make(map[string]any)
+	for k, v := range known {
+		value, ok := dto[k]
+		if !ok {
+			continue
+		}
+
+		knownObj, knownIsObj := v.(map[string]any)
+		obj, isObj := value.(map[string]any)
+		if knownIsObj && isObj {
+			value = filterUserConfig(obj, knownObj)
+		}
+		result[k] = value
+	}
+	return result
+}
+
+// MoveServiceState converts the state of a typed service resource, for instance, aiven_pg,
+// into the state of the aiven_service resource.
+// The typed user config block becomes the JSON-encoded user_config.
+// The fields that aiven_service doesn't have are removed when the state is upgraded.
+func MoveServiceState(sourceTypeName string, state map[string]any) (map[string]any, error) {
+	serviceType, _ := state["service_type"].(string)
+	if serviceType == "" || sourceTypeName != "aiven_"+serviceType {
+		return nil, fmt.Errorf("can't move %s to aiven_service, only the service resources are supported", sourceTypeName)
+	}
+
+	userConfig, err := converters.ExpandState(converters.ServiceUserConfig, serviceType, state)
+	if err != nil {
+		return nil, err
+	}
+
+	state[userConfigJSONKey] = ""
+	if len(userConfig) > 0 {
+		b, err := json.Marshal(userConfig)
+		if err != nil {
+			return nil, err
+		}
+		state[userConfigJSONKey] = string(b)
+	}
+	return state, nil
+}
@@ -0,0 +1,181 @@
+package schemautil
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCustomizeDiffUserConfigJSON(t *testing.T) {
+	cases := []struct {
+		name        string
+		config      map[string]any
+		passwordWo  cty.Value
+		expectError string
+	}{
+		{
+			name:   "valid",
+			config: map[string]any{"service_type": "pg", "user_config": `{"pg_version": "16", "pg": {"max_connections": 100}}`},
+		},
+		{
+			name:   "no user config",
+			config: map[string]any{"service_type": "kafka"},
+		},
+		{
+			name:        "not a JSON object",
+			config:      map[string]any{"service_type": "pg", "user_config": `["pg_version"]`},
+			expectError: "user_config must be a JSON object",
+		},
+		{
+			name:        "invalid for the service type",
+			config:      map[string]any{"service_type": "pg", "user_config": `{"foo": 1}`},
+			expectError: "foo: unknown field",
+		},
+		{
+			name:        "write-only password not supported",
+			config:      map[string]any{"service_type": "grafana"},
+			passwordWo:  cty.StringVal("secret"),
+			expectError: "service_password_wo is not supported by the grafana service type",
+		},
+		{
+			name: "bootstrap integration of the service type",
+			config: map[string]any{
+				"service_type": "pg",
+				"service_integrations": []any{
+					map[string]any{"integration_type": "read_replica", "source_service_name": "foo"},
+				},
+			},
+		},
+		{
+			name: "bootstrap integration not supported",
+			config: map[string]any{
+				"service_type": "kafka",
+				"service_integrations": []any{
+					map[string]any{"integration_type": "read_replica", "source_service_name": "foo"},
+				},
+			},
+			expectError: "service integration read_replica can't be specified for the kafka service type",
+		},
+	}
+
+	r := &schema.Resource{
+		Schema:        ServiceGenericSchema(),
+		CustomizeDiff: CustomizeDiffUserConfigJSON,
+	}
+	for _, opt := range cases {
+		t.Run(opt.name, func(t *testing.T) {
+			passwordWo := opt.passwordWo
+			if passwordWo == cty.NilVal {
+				passwordWo = cty.NullVal(cty.String)
+			}
+
+			// The diff reads the raw config from the state
+			state := &terraform.InstanceState{
+				RawConfig: cty.ObjectVal(map[string]cty.Value{"service_password_wo": passwordWo}),
+			}
+			_, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(opt.config), nil)
+			if opt.expectError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, opt.expectError)
+			}
+		})
+	}
+}
+
+func TestFlattenUserConfigJSON(t *testing.T) {
+	// The API returns the defaults and the options that are not managed
+	dto := map[string]any{
+		"pg_version":    "16",
+		"pg":            map[string]any{"max_connections": 100, "work_mem": 4},
+		"ip_filter":     []any{"0.0.0.0/0"},
+		"admin_enabled": true,
+	}
+
+	cases := []struct {
+		name       string
+		userConfig string
+		expected   string
+	}{
+		{
+			name:       "not set",
+			userConfig: "",
+			expected:   "",
+		},
+		{
+			name:       "managed options only",
+			userConfig: `{"pg_version": "15", "pg": {"max_connections": 50}}`,
+			expected:   `{"pg_version": "16", "pg": {"max_connections": 100}}`,
+		},
+		{
+			name:       "create only fields are kept",
+			userConfig: `{"pg_version": "16", "admin_username": "admin", "admin_password": "secret"}`,
+			expected:   `{"pg_version": "16", "admin_username": "admin", "admin_password": "secret"}`,
+		},
+		{
+			name:       "removed from the API",
+			userConfig: `{"pg_version": "16", "pgbouncer": {"autodb_pool_size": 10}}`,
+			expected:   `{"pg_version": "16"}`,
+		},
+	}
+
+	for _, opt := range cases {
+		t.Run(opt.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, ServiceGenericSchema(), map[string]any{
+				"service_type": "pg",
+				"user_config":  opt.userConfig,
+			})
+			require.NoError(t, flattenUserConfigJSON(d, dto))
+
+			actual := d.Get("user_config").(string)
+			if opt.expected == "" {
+				assert.Empty(t, actual)
+			} else {
+				assert.JSONEq(t, opt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestUserConfigJSONRoundTrip(t *testing.T) {
+	// The big integers and the nested objects survive the expand and flatten
+	userConfig := `{"pg": {"max_connections": 9007199254740993, "log_min_duration_statement": -1}, "ip_filter": ["10.0.0.0/8"]}`
+	d := schema.TestResourceDataRaw(t, ServiceGenericSchema(), map[string]any{
+		"service_type": "pg",
+		"user_config":  userConfig,
+	})
+
+	// The API returns what is sent and adds the defaults
+	dto, err := expandUserConfigJSON(d)
+	require.NoError(t, err)
+	dto["pg_version"] = "16"
+	dto["pg"].(map[string]any)["work_mem"] = 4
+
+	require.NoError(t, flattenUserConfigJSON(d, dto))
+	assert.JSONEq(t, userConfig, d.Get("user_config").(string))
+	assert.Contains(t, d.Get("user_config").(string), "9007199254740993")
+}
+
+func TestFilterUserConfig(t *testing.T) {
+	dto := map[string]any{
+		"pg_version": "16",
+		"pg":         map[string]any{"max_connections": 100, "work_mem": 4},
+		"ip_filter":  []any{"0.0.0.0/0"},
+	}
+	known := map[string]any{
+		"pg":        map[string]any{"max_connections": 50},
+		"ip_filter": []any{},
+		"pgbouncer": map[string]any{},
+	}
+
+	expected := map[string]any{
+		"pg":        map[string]any{"max_connections": 100},
+		"ip_filter": []any{"0.0.0.0/0"},
+	}
+	assert.Equal(t, expected, filterUserConfig(dto, known))
+}
@@ -0,0 +1,239 @@
+package schemautil
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aiven/go-client-codegen/handler/service"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/aiven/terraform-provider-aiven/internal/common"
+)
+
+// recoveryTargetTimeFormat is the format of the recovery_target_time user config option
+const recoveryTargetTimeFormat = "2006-01-02 15:04:05"
+
+// restoreFromUserConfigKeys are the user config options that are set from the restore_from block.
+// The API returns them in the user config of the new service.
+var restoreFromUserConfigKeys = []string{
+	"service_to_fork_from",
+	"project_to_fork_from",
+	"recovery_target_time",
+	"recovery_basebackup_name",
+}
+
+// SupportsRestoreFrom returns true if the service can be forked or restored from a backup of another service.
+func SupportsRestoreFrom(serviceType string) bool {
+	return supportsPointInTimeRecovery(serviceType) || slices.Contains([]string{
+		ServiceTypeClickhouse,
+		ServiceTypeDragonfly,
+		ServiceTypeGrafana,
+		ServiceTypeOpenSearch,
+		ServiceTypeValkey,
+	}, serviceType)
+}
+
+// supportsPointInTimeRecovery returns true if the service is restored to a point in time, otherwise from a backup.
+func supportsPointInTimeRecovery(serviceType string) bool {
+	return slices.Contains([]string{ServiceTypePG, ServiceTypeMySQL}, serviceType)
+}
+
+func restoreFromSchema(kind string) *schema.Schema {
+	s := map[string]*schema.Schema{
+		"service_name": {
+			Type:             schema.TypeString,
+			Required:         true,
+			DiffSuppressFunc: CreateOnlyDiffSuppressFunc,
+			Description:      "Name of the service to restore from. Must be of the same service type.",
+		},
+		"project": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			DiffSuppressFunc: CreateOnlyDiffSuppressFunc,
+			Description:      "Project of the service to restore from. Defaults to the project of this service.",
+		},
+	}
+
+	conflictsWith := []string{
+		kind + "_user_config.0.service_to_fork_from",
+		kind + "_user_config.0.project_to_fork_from",
+	}
+	if supportsPointInTimeRecovery(kind) {
+		conflictsWith = append(conflictsWith, kind+"_user_config.0.recovery_target_time")
+		s["point_in_time"] = &schema.Schema{
+			Type:             schema.TypeString,
+			Optional:         true,
+			DiffSuppressFunc: CreateOnlyDiffSuppressFunc,
+			ValidateFunc:     validation.IsRFC3339Time,
+			Description:      "Restores the data as it was at this time, in RFC3339 format, for example, `2026-01-02T15:04:05Z`. Must be between the earliest backup of the source service and now. Defaults to the latest data.",
+		}
+	} else {
+		conflictsWith = append(conflictsWith, kind+"_user_config.0.recovery_basebackup_name")
+		s["backup_name"] = &schema.Schema{
+			Type:             schema.TypeString,
+			Optional:         true,
+			DiffSuppressFunc: CreateOnlyDiffSuppressFunc,
+			Description:      "Name of the backup of the source service to restore. Defaults to the latest backup.",
+		}
+	}
+
+	return &schema.Schema{
+		Type:             schema.TypeList,
+		Optional:         true,
+		MaxItems:         1,
+		DiffSuppressFunc: CreateOnlyDiffSuppressFunc,
+		ConflictsWith:    conflictsWith,
+		Description:      "Creates the service from a backup of another service, which is validated when planning. Only applied when the service is created, changes are ignored afterwards. The service is created after the data is restored and backed up, increase `timeouts.create` for large services.",
+		Elem:             &schema.Resource{Schema: s},
+	}
+}
+
+// restoreFrom is the restore_from block
+type restoreFrom struct {
+	project     string
+	serviceName string
+	backupName  string
+	pointInTime *time.Time
+}
+
+// getRestoreFrom returns the restore_from block, nil if it is not set
+func getRestoreFrom(d ResourceStateOrResourceDiff) (*restoreFrom, error) {
+	list, _ := d.Get("restore_from").([]any)
+	if len(list) == 0 {
+		return nil, nil
+	}
+
+	m, _ := list[0].(map[string]any)
+	r := &restoreFrom{project: d.Get("project").(string)}
+	r.serviceName, _ = m["service_name"].(string)
+
+	if v, _ := m["project"].(string); v != "" {
+		r.project = v
+	}
+
+	if v, _ := m["backup_name"].(string); v != "" {
+		r.backupName = v
+	}
+
+	if v, _ := m["point_in_time"].(string); v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid restore_from point_in_time: %w", err)
+		}
+		r.pointInTime = &t
+	}
+	return r, nil
+}
+
+// setRestoreFromUserConfig sets the user config options for the restore_from block
+func setRestoreFromUserConfig(d ResourceStateOrResourceDiff, userConfig map[string]any) error {
+	r, err := getRestoreFrom(d)
+	if r == nil || err != nil {
+		return err
+	}
+
+	userConfig["service_to_fork_from"] = r.serviceName
+	userConfig["project_to_fork_from"] = r.project
+	if r.backupName != "" {
+		userConfig["recovery_basebackup_name"] = r.backupName
+	}
+	if r.pointInTime != nil {
+		userConfig["recovery_target_time"] = r.pointInTime.UTC().Format(recoveryTargetTimeFormat)
+	}
+	return nil
+}
+
+// removeRestoreFromUserConfig removes the user config options set from the restore_from block,
+// otherwise the user config would show a diff that recreates the service.
+func removeRestoreFromUserConfig(d ResourceStateOrResourceDiff, userConfig map[string]any) {
+	if list, _ := d.Get("restore_from").([]any); len(list) == 0 {
+		return
+	}
+
+	for _, k := range restoreFromUserConfigKeys {
+		delete(userConfig, k)
+	}
+}
+
+// CustomizeDiffRestoreFrom validates the restore_from block against the backups of the source service.
+// The block is create-only, so it is validated only when the service is created.
+func CustomizeDiffRestoreFrom(ctx context.Context, d *schema.ResourceDiff, _ any) error {
+	if d.Id() != "" {
+		return nil
+	}
+
+	// The source service might be created in the same plan
+	for _, k := range []string{"project", "restore_from.0.service_name", "restore_from.0.project"} {
+		if !d.NewValueKnown(k) {
+			return nil
+		}
+	}
+
+	r, err := getRestoreFrom(d)
+	if r == nil || err != nil {
+		return err
+	}
+
+	client, err := common.GenClient()
+	if err != nil {
+		return err
+	}
+
+	s, err := client.ServiceGet(ctx, r.project, r.serviceName)
+	if err != nil {
+		return fmt.Errorf("unable to get the service %s/%s to restore from: %w", r.project, r.serviceName, err)
+	}
+
+	serviceType := d.Get("service_type").(string)
+	if s.ServiceType != serviceType {
+		return fmt.Errorf("can't restore a %s service from the %s service %s/%s", serviceType, s.ServiceType, r.project, r.serviceName)
+	}
+
+	return validateRestoreFrom(r, s.Backups, time.Now())
+}
+
+// validateRestoreFrom checks that the backup or the point in time can be restored from the backups
+func validateRestoreFrom(r *restoreFrom, backups []service.BackupOut, now time.Time) error {
+	if len(backups) == 0 {
+		return fmt.Errorf("the service %s/%s has no backups to restore from", r.project, r.serviceName)
+	}
+
+	if r.backupName != "" {
+		names := make([]string, 0, len(backups))
+		for _, b := range backups {
+			if b.BackupName == r.backupName {
+				return nil
+			}
+			names = append(names, b.BackupName)
+		}
+		return fmt.Errorf("the backup %q is not found in the service %s/%s, available backups: %s", r.backupName, r.project, r.serviceName, strings.Join(names, ", "))
+	}
+
+	if r.pointInTime != nil {
+		earliest := slices.MinFunc(backups, func(a, b service.BackupOut) int {
+			return a.BackupTime.Compare(b.BackupTime)
+		}).BackupTime
+
+		if r.pointInTime.Before(earliest) {
+			return fmt.Errorf("the point_in_time %s is before the earliest backup of the service %s/%s at %s", r.pointInTime.Format(time.RFC3339), r.project, r.serviceName, earliest.Format(time.RFC3339))
+		}
+
+		if r.pointInTime.After(now) {
+			return fmt.Errorf("the point_in_time %s is in the future", r.pointInTime.Format(time.RFC3339))
+		}
+	}
+	return nil
+}
+
+// restoreReady returns true if the service is not restored from another service,
+// or the restored service has its first backup, so the data is restored.
+func restoreReady(d ResourceData, s *service.ServiceGetOut) bool {
+	if list, _ := d.Get("restore_from").([]any); len(list) == 0 {
+		return true
+	}
+	return len(s.Backups) > 0
+}
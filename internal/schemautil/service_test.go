@@ -2,12 +2,17 @@ package schemautil
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"testing"
+	"time"
 
 	avngen "github.com/aiven/go-client-codegen"
+	"github.com/aiven/go-client-codegen/handler/project"
 	"github.com/aiven/go-client-codegen/handler/service"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/aiven/terraform-provider-aiven/mocks"
 )
@@ -44,8 +49,6 @@ func TestContainsRedactedCreds(t *testing.T) {
 }
 
 func TestUpsertServicePassword(t *testing.T) {
-	t.Skip("This test will be enabled once service support write-only passwords")
-
 	t.Parallel()
 
 	t.Run("non-supported service types do nothing", func(t *testing.T) {
@@ -55,9 +58,10 @@ func TestUpsertServicePassword(t *testing.T) {
 			name        string
 			serviceType string
 		}{
-			{"clickhouse", ServiceTypeClickhouse},
 			{"cassandra", ServiceTypeCassandra},
 			{"thanos", ServiceTypeThanos},
+			{"grafana", ServiceTypeGrafana},
+			{"dragonfly", ServiceTypeDragonfly},
 		}
 
 		for _, tc := range testCases {
@@ -84,9 +88,8 @@ func TestUpsertServicePassword(t *testing.T) {
 			{"pg", ServiceTypePG, "avnadmin"},
 			{"mysql", ServiceTypeMySQL, "avnadmin"},
 			{"opensearch", ServiceTypeOpenSearch, "avnadmin"},
-			{"grafana", ServiceTypeGrafana, "avnadmin"},
+			{"clickhouse", ServiceTypeClickhouse, "avnadmin"},
 			{"kafka", ServiceTypeKafka, "avnadmin"},
-			{"dragonfly", ServiceTypeDragonfly, "default"},
 			{"valkey", ServiceTypeValkey, "default"},
 		}
 
@@ -218,6 +221,53 @@ func TestUpsertServicePassword(t *testing.T) {
 	})
 }
 
+func TestAdminUsername(t *testing.T) {
+	cases := []struct {
+		name        string
+		serviceType string
+		service     *service.ServiceGetOut
+		expected    string
+	}{
+		{
+			name:        "user from service uri params",
+			serviceType: ServiceTypePG,
+			service: &service.ServiceGetOut{
+				ServiceUriParams: map[string]any{"user": "custom_admin"},
+			},
+			expected: "custom_admin",
+		},
+		{
+			name:        "primary user",
+			serviceType: ServiceTypeKafka,
+			service: &service.ServiceGetOut{
+				Users: []service.UserOut{
+					{Username: "foo", Type: "regular"},
+					{Username: "avnadmin", Type: "primary"},
+				},
+			},
+			expected: "avnadmin",
+		},
+		{
+			name:        "valkey default",
+			serviceType: ServiceTypeValkey,
+			service:     &service.ServiceGetOut{},
+			expected:    "default",
+		},
+		{
+			name:        "clickhouse default",
+			serviceType: ServiceTypeClickhouse,
+			service:     &service.ServiceGetOut{},
+			expected:    "avnadmin",
+		},
+	}
+
+	for _, opt := range cases {
+		t.Run(opt.name, func(t *testing.T) {
+			assert.Equal(t, opt.expected, adminUsername(opt.service, opt.serviceType))
+		})
+	}
+}
+
 func TestFlattenServiceComponents(t *testing.T) {
 	t.Parallel()
 
@@ -347,3 +397,284 @@ func TestFlattenServiceComponents(t *testing.T) {
 		})
 	}
 }
+
+func TestConfiguredPowerState(t *testing.T) {
+	t.Run("not managed", func(t *testing.T) {
+		d := mocks.NewMockResourceData(t)
+		d.EXPECT().GetRawConfig().Return(cty.ObjectVal(map[string]cty.Value{
+			"powered": cty.NullVal(cty.Bool),
+		}))
+		assert.Nil(t, configuredPowerState(d))
+	})
+
+	t.Run("powered off", func(t *testing.T) {
+		d := mocks.NewMockResourceData(t)
+		d.EXPECT().GetRawConfig().Return(cty.ObjectVal(map[string]cty.Value{
+			"powered": cty.False,
+		}))
+		d.EXPECT().Get("powered").Return(false)
+		assert.Equal(t, new(false), configuredPowerState(d))
+	})
+}
+
+func TestIsServicePoweredOff(t *testing.T) {
+	ctx := context.Background()
+	client := avngen.NewMockClient(t)
+	client.EXPECT().
+		ServiceGet(ctx, "test-project", "powered-off").
+		Return(&service.ServiceGetOut{State: service.ServiceStateTypePoweroff}, nil).
+		Once()
+	client.EXPECT().
+		ServiceGet(ctx, "test-project", "running").
+		Return(&service.ServiceGetOut{State: service.ServiceStateTypeRunning}, nil).
+		Once()
+
+	t.Cleanup(func() {
+		ServicePoweredOffForget("test-project", "powered-off")
+		ServicePoweredOffForget("test-project", "running")
+	})
+
+	// The error is enough, the service is not fetched
+	assert.True(t, IsServicePoweredOff(ctx, client, "test-project", "foo", ErrServicePoweredOff))
+
+	// The state is fetched once and cached
+	err := errors.New("503: An error occurred. Please try again later.")
+	assert.True(t, IsServicePoweredOff(ctx, client, "test-project", "powered-off", err))
+	assert.True(t, IsServicePoweredOff(ctx, client, "test-project", "powered-off", err))
+	assert.False(t, IsServicePoweredOff(ctx, client, "test-project", "running", err))
+}
+
+func TestConfigTags(t *testing.T) {
+	tagType := cty.Object(map[string]cty.Type{"key": cty.String, "value": cty.String})
+	cases := []struct {
+		name        string
+		tag         cty.Value
+		expect      []tagConfig
+		expectKnown bool
+	}{
+		{
+			name:        "not set",
+			tag:         cty.NullVal(cty.Set(tagType)),
+			expectKnown: true,
+		},
+		{
+			name: "known",
+			tag: cty.SetVal([]cty.Value{
+				cty.ObjectVal(map[string]cty.Value{"key": cty.StringVal("env"), "value": cty.StringVal("dev")}),
+			}),
+			expect:      []tagConfig{{key: "env", value: "dev"}},
+			expectKnown: true,
+		},
+		{
+			name: "unknown value",
+			tag: cty.SetVal([]cty.Value{
+				cty.ObjectVal(map[string]cty.Value{"key": cty.StringVal("env"), "value": cty.UnknownVal(cty.String)}),
+			}),
+			expectKnown: false,
+		},
+	}
+
+	for _, opt := range cases {
+		t.Run(opt.name, func(t *testing.T) {
+			d := mocks.NewMockResourceData(t)
+			d.EXPECT().GetRawConfig().Return(cty.ObjectVal(map[string]cty.Value{"tag": opt.tag}))
+
+			actual, known := configTags(d)
+			assert.Equal(t, opt.expectKnown, known)
+			assert.Equal(t, opt.expect, actual)
+		})
+	}
+}
+
+func TestValidateRestoreFrom(t *testing.T) {
+	now := time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)
+	backups := []service.BackupOut{
+		{BackupName: "backup-2", BackupTime: now.Add(-24 * time.Hour)},
+		{BackupName: "backup-1", BackupTime: now.Add(-48 * time.Hour)},
+	}
+
+	cases := []struct {
+		name        string
+		restore     restoreFrom
+		backups     []service.BackupOut
+		expectError string
+	}{
+		{
+			name:    "latest",
+			restore: restoreFrom{project: "foo", serviceName: "bar"},
+			backups: backups,
+		},
+		{
+			name:        "no backups",
+			restore:     restoreFrom{project: "foo", serviceName: "bar"},
+			expectError: "the service foo/bar has no backups to restore from",
+		},
+		{
+			name:    "backup found",
+			restore: restoreFrom{project: "foo", serviceName: "bar", backupName: "backup-1"},
+			backups: backups,
+		},
+		{
+			name:        "backup not found",
+			restore:     restoreFrom{project: "foo", serviceName: "bar", backupName: "backup-0"},
+			backups:     backups,
+			expectError: `the backup "backup-0" is not found in the service foo/bar, available backups: backup-2, backup-1`,
+		},
+		{
+			name:    "point in time",
+			restore: restoreFrom{project: "foo", serviceName: "bar", pointInTime: new(now.Add(-36 * time.Hour))},
+			backups: backups,
+		},
+		{
+			name:        "point in time before the earliest backup",
+			restore:     restoreFrom{project: "foo", serviceName: "bar", pointInTime: new(now.Add(-72 * time.Hour))},
+			backups:     backups,
+			expectError: "the point_in_time 2026-01-07T00:00:00Z is before the earliest backup of the service foo/bar at 2026-01-08T00:00:00Z",
+		},
+		{
+			name:        "point in time in the future",
+			restore:     restoreFrom{project: "foo", serviceName: "bar", pointInTime: new(now.Add(time.Hour))},
+			backups:     backups,
+			expectError: "the point_in_time 2026-01-10T01:00:00Z is in the future",
+		},
+	}
+
+	for _, opt := range cases {
+		t.Run(opt.name, func(t *testing.T) {
+			err := validateRestoreFrom(&opt.restore, opt.backups, now)
+			if opt.expectError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, opt.expectError)
+			}
+		})
+	}
+}
+
+func TestRestoreFromUserConfig(t *testing.T) {
+	d := mocks.NewMockResourceData(t)
+	d.EXPECT().Get("project").Return("foo")
+	d.EXPECT().Get("restore_from").Return([]any{
+		map[string]any{
+			"service_name":  "bar",
+			"project":       "",
+			"point_in_time": "2026-01-02T17:04:05+02:00",
+		},
+	})
+
+	userConfig := map[string]any{"pg_version": "17"}
+	assert.NoError(t, setRestoreFromUserConfig(d, userConfig))
+	expected := map[string]any{
+		"pg_version":           "17",
+		"service_to_fork_from": "bar",
+		"project_to_fork_from": "foo",
+		"recovery_target_time": "2026-01-02 15:04:05",
+	}
+	assert.Equal(t, expected, userConfig)
+
+	// The API returns the options in the user config, they are not stored
+	removeRestoreFromUserConfig(d, userConfig)
+	assert.Equal(t, map[string]any{"pg_version": "17"}, userConfig)
+}
+
+func TestFlattenServiceNodeStates(t *testing.T) {
+	s := new(service.ServiceGetOut)
+	err := json.Unmarshal([]byte(`{
+		"node_states": [
+			{"name": "foo-1", "role": "master", "state": "running", "progress_updates": []},
+			{
+				"name": "foo-2",
+				"role": "standby",
+				"state": "syncing_data",
+				"progress_updates": [
+					{"phase": "basebackup", "completed": false, "current": 10, "min": 0, "max": 100, "unit": "bytes_compressed"}
+				]
+			}
+		]
+	}`), s)
+	require.NoError(t, err)
+
+	actual, err := FlattenServiceNodeStates(s)
+	require.NoError(t, err)
+	expected := []map[string]any{
+		{
+			"name":             "foo-1",
+			"role":             "master",
+			"state":            "running",
+			"progress_updates": []map[string]any{},
+		},
+		{
+			"name":  "foo-2",
+			"role":  "standby",
+			"state": "syncing_data",
+			"progress_updates": []map[string]any{
+				{"phase": "basebackup", "completed": false, "current": 10, "min": 0, "max": 100, "unit": "bytes_compressed"},
+			},
+		},
+	}
+	assert.Equal(t, expected, actual)
+}
+
+func TestFlattenServiceMaintenanceUpdates(t *testing.T) {
+	s := new(service.ServiceGetOut)
+	err := json.Unmarshal([]byte(`{
+		"maintenance": {
+			"dow": "monday",
+			"time": "10:00:00",
+			"enabled": true,
+			"updates": [
+				{"description": "Update to the latest minor version", "deadline": "2026-11-01T10:00:00Z", "impact": "The nodes are restarted"},
+				{"description": "Operating system update"}
+			]
+		}
+	}`), s)
+	require.NoError(t, err)
+
+	actual, err := FlattenServiceMaintenanceUpdates(s)
+	require.NoError(t, err)
+	expected := []map[string]any{
+		{"description": "Update to the latest minor version", "deadline": "2026-11-01T10:00:00Z", "impact": "The nodes are restarted"},
+		{"description": "Operating system update", "deadline": "", "impact": ""},
+	}
+	assert.Equal(t, expected, actual)
+}
+
+func TestMonthlyCost(t *testing.T) {
+	price := &project.ProjectServicePlanPriceGetOut{
+		BasePriceUsd:           "0.5",
+		ExtraDiskPricePerGbUsd: new("0.001"),
+	}
+
+	cost, err := monthlyCost(price, 0)
+	require.NoError(t, err)
+	assert.Equal(t, "365.00", formatCost(cost))
+
+	// 100 GiB of additional disk space
+	cost, err = monthlyCost(price, 100*1024)
+	require.NoError(t, err)
+	assert.Equal(t, "438.00", formatCost(cost))
+
+	// The cloud has no additional disk space price
+	_, err = monthlyCost(&project.ProjectServicePlanPriceGetOut{BasePriceUsd: "0.5"}, 1024)
+	assert.ErrorContains(t, err, "invalid additional disk space price")
+}
+
+func TestEstimatedCostInputs(t *testing.T) {
+	d := mocks.NewMockResourceData(t)
+	d.EXPECT().Get("plan").Return("startup-4").Once()
+	d.EXPECT().Get("cloud_name").Return("google-europe-west1").Once()
+	d.EXPECT().Get("disk_space_used").Return("80GiB").Once()
+	before := estimatedCostInputs(d)
+
+	// The same inputs don't estimate the cost again
+	d.EXPECT().Get("plan").Return("startup-4").Once()
+	d.EXPECT().Get("cloud_name").Return("google-europe-west1").Once()
+	d.EXPECT().Get("disk_space_used").Return("80GiB").Once()
+	assert.Equal(t, before, estimatedCostInputs(d))
+
+	// The additional disk space changes the cost
+	d.EXPECT().Get("plan").Return("startup-4").Once()
+	d.EXPECT().Get("cloud_name").Return("google-europe-west1").Once()
+	d.EXPECT().Get("disk_space_used").Return("100GiB").Once()
+	assert.NotEqual(t, before, estimatedCostInputs(d))
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 
 	"github.com/aiven/terraform-provider-aiven/internal/common"
+	"github.com/aiven/terraform-provider-aiven/internal/plugin/errmsg"
 )
 
 func ResourceServiceUserCreate(ctx context.Context, d *schema.ResourceData, client avngen.Client) diag.Diagnostics {
@@ -87,6 +88,13 @@ func ResourceServiceUserRead(ctx context.Context, d *schema.ResourceData, client
 		retry.LastErrorOnly(true), // retry returns a list of errors by default
 	)
 	if err != nil {
+		if !d.IsNewResource() && IsServicePoweredOff(ctx, client, projectName, serviceName, err) {
+			return diag.Diagnostics{{
+				Severity: diag.Warning,
+				Summary:  errmsg.SummaryServicePoweredOff,
+				Detail:   fmt.Sprintf(errmsg.DetailServicePoweredOff, projectName, serviceName, d.Id()),
+			}}
+		}
 		return diag.FromErr(ResourceReadHandleNotFound(err, d))
 	}
 
@@ -6,6 +6,7 @@ import (
 	"log"
 	"net"
 	"path/filepath"
+	"reflect"
 	"strconv"
 	"sync"
 	"time"
@@ -14,6 +15,7 @@ import (
 	"github.com/aiven/go-client-codegen/handler/service"
 	"github.com/aiven/go-client-codegen/handler/staticip"
 	retryGo "github.com/avast/retry-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/samber/lo"
@@ -27,13 +29,36 @@ const (
 	aivenPendingState          = "REBUILDING"
 	aivenRebalancingState      = "REBALANCING"
 	aivenServicesStartingState = "WAITING_FOR_SERVICES"
+	aivenPoweroffState         = "POWEROFF"
+	aivenNodeRunningState      = "running"
+)
+
+const (
+	// ReadinessModeFast waits until the service is RUNNING
+	ReadinessModeFast = "fast"
+	// ReadinessModeDefault also waits for the backups, Grafana and static IPs
+	ReadinessModeDefault = "default"
+	// ReadinessModeStrict also waits for the nodes, the components DNS and Kafka rebalancing
+	ReadinessModeStrict = "strict"
+)
+
+var readinessModes = []string{ReadinessModeFast, ReadinessModeDefault, ReadinessModeStrict}
+
+// lookupHost resolves the component hosts, replaced in tests
+var lookupHost = net.DefaultResolver.LookupHost
+
+// maintenanceDelay and maintenanceMinTimeout of WaitForServiceMaintenance, replaced in tests
+var (
+	maintenanceDelay      = common.DefaultStateChangeDelay
+	maintenanceMinTimeout = common.DefaultStateChangeMinTimeout
 )
 
 func WaitForServiceCreation(ctx context.Context, d ResourceData, client avngen.Client) (*service.ServiceGetOut, error) {
 	projectName, serviceName := d.Get("project").(string), d.Get("service_name").(string)
 
 	timeout := d.Timeout(schema.TimeoutCreate)
-	log.Printf("[DEBUG] Service creation waiter timeout %.0f minutes", timeout.Minutes())
+	mode := readinessMode(d)
+	log.Printf("[DEBUG] Service creation waiter timeout %.0f minutes, readiness mode %s", timeout.Minutes(), mode)
 
 	conf := &retry.StateChangeConf{
 		Pending:                   []string{aivenPendingState, aivenRebalancingState, aivenServicesStartingState},
@@ -41,7 +66,7 @@ func WaitForServiceCreation(ctx context.Context, d ResourceData, client avngen.C
 		Delay:                     common.DefaultStateChangeDelay,
 		Timeout:                   timeout,
 		MinTimeout:                common.DefaultStateChangeMinTimeout,
-		ContinuousTargetOccurence: 5,
+		ContinuousTargetOccurence: continuousTargetOccurence(mode),
 		Refresh: func() (any, string, error) {
 			s, err := client.ServiceGet(ctx, projectName, serviceName)
 			if err != nil {
@@ -54,11 +79,20 @@ func WaitForServiceCreation(ctx context.Context, d ResourceData, client avngen.C
 				return s, state, nil
 			}
 
+			if mode == ReadinessModeFast {
+				return s, state, nil
+			}
+
 			if rdy := backupsReady(s); !rdy {
 				log.Printf("[DEBUG] service reports as %s, still waiting for service backups", state)
 				return s, aivenServicesStartingState, nil
 			}
 
+			if rdy := restoreReady(d, s); !rdy {
+				log.Printf("[DEBUG] service reports as %s, still waiting for the restore", state)
+				return s, aivenServicesStartingState, nil
+			}
+
 			if rdy := grafanaReady(s); !rdy {
 				log.Printf("[DEBUG] service reports as %s, still waiting for grafana", state)
 				return s, aivenServicesStartingState, nil
@@ -71,6 +105,10 @@ func WaitForServiceCreation(ctx context.Context, d ResourceData, client avngen.C
 				return s, aivenServicesStartingState, nil
 			}
 
+			if mode == ReadinessModeStrict && !strictReady(ctx, s) {
+				return s, aivenServicesStartingState, nil
+			}
+
 			return s, state, nil
 		},
 	}
@@ -86,7 +124,15 @@ func WaitForServiceUpdate(ctx context.Context, d ResourceData, client avngen.Cli
 	projectName, serviceName := d.Get("project").(string), d.Get("service_name").(string)
 
 	timeout := d.Timeout(schema.TimeoutUpdate)
-	log.Printf("[DEBUG] Service update waiter timeout %.0f minutes", timeout.Minutes())
+	if d.IsNewResource() {
+		// Resources that update the service when created, for instance, aiven_service_maintenance
+		timeout = d.Timeout(schema.TimeoutCreate)
+	}
+	mode := readinessMode(d)
+	log.Printf("[DEBUG] Service update waiter timeout %.0f minutes, readiness mode %s", timeout.Minutes(), mode)
+
+	// The last logged node states and progress updates
+	progress := make(map[string]string)
 
 	conf := &retry.StateChangeConf{
 		Pending:                   []string{"updating"},
@@ -94,7 +140,7 @@ func WaitForServiceUpdate(ctx context.Context, d ResourceData, client avngen.Cli
 		Delay:                     common.DefaultStateChangeDelay,
 		Timeout:                   timeout,
 		MinTimeout:                common.DefaultStateChangeMinTimeout,
-		ContinuousTargetOccurence: 5,
+		ContinuousTargetOccurence: continuousTargetOccurence(mode),
 		Refresh: func() (any, string, error) {
 			s, err := client.ServiceGet(ctx, projectName, serviceName)
 			if err != nil {
@@ -102,6 +148,15 @@ func WaitForServiceUpdate(ctx context.Context, d ResourceData, client avngen.Cli
 			}
 
 			state := s.State
+			logNodeProgress(ctx, s, progress)
+
+			if mode == ReadinessModeFast {
+				if string(state) != aivenTargetState {
+					log.Printf("[DEBUG] service reports as %s, still for it to be in state %s", state, aivenTargetState)
+					return s, "updating", nil
+				}
+				return s, "updated", nil
+			}
 
 			if rdy := backupsReady(s); !rdy {
 				log.Printf("[DEBUG] service reports as %s, still waiting for service backups", state)
@@ -120,6 +175,10 @@ func WaitForServiceUpdate(ctx context.Context, d ResourceData, client avngen.Cli
 				return s, "updating", nil
 			}
 
+			if mode == ReadinessModeStrict && !strictReady(ctx, s) {
+				return s, "updating", nil
+			}
+
 			return s, "updated", nil
 		},
 	}
@@ -131,6 +190,115 @@ func WaitForServiceUpdate(ctx context.Context, d ResourceData, client avngen.Cli
 	return aux.(*service.ServiceGetOut), nil
 }
 
+// WaitForServiceMaintenance waits until the maintenance started with ServiceMaintenanceStart is applied.
+// The service may stay RUNNING for a while after the start, so it waits until the service leaves RUNNING
+// or the pending updates change, then until the service is RUNNING again.
+// Doesn't check the readiness of the service, see WaitForServiceUpdate.
+func WaitForServiceMaintenance(ctx context.Context, d ResourceData, client avngen.Client, updates []map[string]any) (*service.ServiceGetOut, error) {
+	projectName, serviceName := d.Get("project").(string), d.Get("service_name").(string)
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutCreate)
+	}
+	log.Printf("[DEBUG] Service maintenance waiter timeout %.0f minutes", timeout.Minutes())
+
+	started := false
+	conf := &retry.StateChangeConf{
+		Pending:    []string{"pending", "started"},
+		Target:     []string{"applied"},
+		Delay:      maintenanceDelay,
+		Timeout:    timeout,
+		MinTimeout: maintenanceMinTimeout,
+		Refresh: func() (any, string, error) {
+			s, err := client.ServiceGet(ctx, projectName, serviceName)
+			if err != nil {
+				return nil, "", fmt.Errorf("unable to fetch service from api: %w", err)
+			}
+
+			if string(s.State) != aivenTargetState {
+				log.Printf("[DEBUG] service reports as %s, still waiting for the maintenance", s.State)
+				started = true
+				return s, "started", nil
+			}
+
+			if started {
+				return s, "applied", nil
+			}
+
+			// The updates can be applied without changing the state
+			current, err := FlattenServiceMaintenanceUpdates(s)
+			if err != nil {
+				return nil, "", err
+			}
+
+			if reflect.DeepEqual(current, updates) {
+				log.Printf("[DEBUG] service reports as %s, still waiting for the maintenance to start", s.State)
+				return s, "pending", nil
+			}
+			return s, "applied", nil
+		},
+	}
+
+	aux, err := conf.WaitForStateContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to wait for service maintenance: %w", err)
+	}
+	return aux.(*service.ServiceGetOut), nil
+}
+
+// WaitForServicePowerState waits until the service is powered on (RUNNING) or powered off (POWEROFF).
+func WaitForServicePowerState(ctx context.Context, d ResourceData, client avngen.Client, powered bool) (*service.ServiceGetOut, error) {
+	projectName, serviceName := d.Get("project").(string), d.Get("service_name").(string)
+	return WaitForServicePowerStateByName(ctx, client, projectName, serviceName, powered, d.Timeout(schema.TimeoutUpdate))
+}
+
+// WaitForServicePowerStateByName same as WaitForServicePowerState, for the callers without ResourceData, e.g., actions.
+func WaitForServicePowerStateByName(
+	ctx context.Context,
+	client avngen.Client,
+	projectName, serviceName string,
+	powered bool,
+	timeout time.Duration,
+) (*service.ServiceGetOut, error) {
+	target, pending := aivenPoweroffState, aivenTargetState
+	if powered {
+		target, pending = aivenTargetState, aivenPoweroffState
+	}
+
+	log.Printf("[DEBUG] Service power state waiter timeout %.0f minutes", timeout.Minutes())
+
+	conf := &retry.StateChangeConf{
+		Pending:                   []string{pending, aivenPendingState, aivenRebalancingState, aivenServicesStartingState},
+		Target:                    []string{target},
+		Delay:                     common.DefaultStateChangeDelay,
+		Timeout:                   timeout,
+		MinTimeout:                common.DefaultStateChangeMinTimeout,
+		ContinuousTargetOccurence: 5,
+		Refresh: func() (any, string, error) {
+			s, err := client.ServiceGet(ctx, projectName, serviceName)
+			if err != nil {
+				return nil, "", fmt.Errorf("unable to fetch service from api: %w", err)
+			}
+
+			state := string(s.State)
+			if state != target {
+				log.Printf("[DEBUG] service reports as %s, still waiting for it to be in state %s", state, target)
+			}
+			return s, state, nil
+		},
+	}
+
+	aux, err := conf.WaitForStateContext(ctx)
+
+	// The power state has changed, or it is unknown after the error
+	servicePoweredOff.Forget(projectName, serviceName)
+	if err != nil {
+		return nil, fmt.Errorf("unable to wait for service power state change: %w", err)
+	}
+	return aux.(*service.ServiceGetOut), nil
+}
+
 func WaitStaticIpsDissociation(ctx context.Context, d ResourceData, client avngen.Client) error {
 	timeout := d.Timeout(schema.TimeoutDelete)
 	log.Printf("[DEBUG] Static Ip dissassociation timeout %.0f minutes", timeout.Minutes())
@@ -236,6 +404,104 @@ func grafanaReady(s *service.ServiceGetOut) bool {
 	return true
 }
 
+// readinessMode returns the readiness mode of the service, the default mode if it is not set
+func readinessMode(d ResourceData) string {
+	list, _ := d.Get("readiness").([]any)
+	if len(list) == 0 {
+		return ReadinessModeDefault
+	}
+
+	m, _ := list[0].(map[string]any)
+	if mode, _ := m["mode"].(string); mode != "" {
+		return mode
+	}
+	return ReadinessModeDefault
+}
+
+// continuousTargetOccurence the fast mode returns on the first RUNNING state,
+// otherwise the state must be stable for several checks.
+func continuousTargetOccurence(mode string) int {
+	if mode == ReadinessModeFast {
+		return 1
+	}
+	return 5
+}
+
+// strictReady checks that the service is RUNNING (Kafka is not rebalancing), all the nodes are running,
+// and the component hosts are resolvable in DNS.
+// The private and privatelink routes resolve only inside the VPC or through the privatelink connection,
+// which Terraform might not have, so only the public and dynamic routes are resolved.
+func strictReady(ctx context.Context, s *service.ServiceGetOut) bool {
+	if string(s.State) != aivenTargetState {
+		log.Printf("[DEBUG] service reports as %s, still for it to be in state %s", s.State, aivenTargetState)
+		return false
+	}
+
+	for _, n := range s.NodeStates {
+		if string(n.State) != aivenNodeRunningState {
+			log.Printf("[DEBUG] node %s reports as %s, still waiting for all nodes to be running", n.Name, n.State)
+			return false
+		}
+	}
+
+	hosts := make(map[string]bool)
+	for _, c := range s.Components {
+		if c.Host == "" || hosts[c.Host] || !isResolvableRoute(c.Route) {
+			continue
+		}
+		hosts[c.Host] = true
+
+		if _, err := lookupHost(ctx, c.Host); err != nil {
+			log.Printf("[DEBUG] component %s host %s is not yet resolvable: %s", c.Component, c.Host, err)
+			return false
+		}
+	}
+	return true
+}
+
+// isResolvableRoute returns true for the routes that resolve from anywhere.
+func isResolvableRoute(route service.RouteType) bool {
+	switch route {
+	case service.RouteTypePrivate, service.RouteTypePrivatelink:
+		return false
+	}
+	return true
+}
+
+// logNodeProgress logs the node states and the progress updates with tflog, when they change.
+// Shows what the service is doing during rebalancing and migrations.
+func logNodeProgress(ctx context.Context, s *service.ServiceGetOut, last map[string]string) {
+	nodes, err := getNodeStates(s)
+	if err != nil {
+		log.Printf("[DEBUG] %s", err)
+		return
+	}
+
+	for _, n := range nodes {
+		if last[n.Name] != n.State {
+			last[n.Name] = n.State
+			tflog.Info(ctx, fmt.Sprintf("Service %s node %s is %s", s.ServiceName, n.Name, n.State), map[string]any{
+				"service_state": s.State,
+				"node_role":     n.Role,
+			})
+		}
+
+		for _, u := range n.ProgressUpdates {
+			key := n.Name + "/" + u.Phase
+			value := fmt.Sprintf("%t %d/%d", u.Completed, u.Current, u.Max)
+			if last[key] == value {
+				continue
+			}
+
+			last[key] = value
+			tflog.Info(ctx, fmt.Sprintf("Service %s node %s phase %s: %d/%d %s", s.ServiceName, n.Name, u.Phase, u.Current, u.Max, u.Unit), map[string]any{
+				"completed": u.Completed,
+				"min":       u.Min,
+			})
+		}
+	}
+}
+
 func backupsReady(s *service.ServiceGetOut) bool {
 	switch s.ServiceType {
 	case ServiceTypePG, ServiceTypeDragonfly:
@@ -351,7 +617,12 @@ L:
 }
 
 func staticIpsForServiceFromSchema(d ResourceData) []string {
-	return FlattenToString(d.Get("static_ips").(*schema.Set).List())
+	// Resources that wait for the service may not have the field
+	set, ok := d.Get("static_ips").(*schema.Set)
+	if !ok {
+		return nil
+	}
+	return FlattenToString(set.List())
 }
 
 // WaitUntilNotFound retries the given retryableFunc until it returns 404
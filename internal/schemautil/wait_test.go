@@ -1,12 +1,20 @@
 package schemautil
 
 import (
+	"context"
+	"errors"
 	"sync"
 	"sync/atomic"
 	"testing"
+	"time"
 
+	avngen "github.com/aiven/go-client-codegen"
+	"github.com/aiven/go-client-codegen/handler/service"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/aiven/terraform-provider-aiven/mocks"
 )
 
 func TestDoOnce(t *testing.T) {
@@ -31,3 +39,141 @@ func TestDoOnce(t *testing.T) {
 	// Called only once
 	assert.Equal(t, int64(1), incr)
 }
+
+func TestReadinessMode(t *testing.T) {
+	cases := []struct {
+		name      string
+		readiness any
+		expect    string
+	}{
+		{name: "not set", readiness: []any{}, expect: ReadinessModeDefault},
+		{name: "empty block", readiness: []any{nil}, expect: ReadinessModeDefault},
+		{name: "fast", readiness: []any{map[string]any{"mode": "fast"}}, expect: ReadinessModeFast},
+		{name: "strict", readiness: []any{map[string]any{"mode": "strict"}}, expect: ReadinessModeStrict},
+	}
+
+	for _, opt := range cases {
+		t.Run(opt.name, func(t *testing.T) {
+			d := mocks.NewMockResourceData(t)
+			d.EXPECT().Get("readiness").Return(opt.readiness)
+			assert.Equal(t, opt.expect, readinessMode(d))
+		})
+	}
+}
+
+func TestStrictReady(t *testing.T) {
+	resolvable := map[string]bool{"foo.aivencloud.com": true}
+	origLookupHost := lookupHost
+	t.Cleanup(func() { lookupHost = origLookupHost })
+	lookupHost = func(_ context.Context, host string) ([]string, error) {
+		if resolvable[host] {
+			return []string{"127.0.0.1"}, nil
+		}
+		return nil, errors.New("no such host")
+	}
+
+	ready := func() *service.ServiceGetOut {
+		return &service.ServiceGetOut{
+			State: service.ServiceStateTypeRunning,
+			NodeStates: []service.NodeStateOut{
+				{Name: "foo-1", State: "running"},
+				{Name: "foo-2", State: "running"},
+			},
+			Components: []service.ComponentOut{
+				{Component: "kafka", Host: "foo.aivencloud.com"},
+				{Component: "schema_registry", Host: "foo.aivencloud.com"},
+			},
+		}
+	}
+	assert.True(t, strictReady(t.Context(), ready()))
+
+	rebalancing := ready()
+	rebalancing.State = service.ServiceStateTypeRebalancing
+	assert.False(t, strictReady(t.Context(), rebalancing))
+
+	syncing := ready()
+	syncing.NodeStates[1].State = "syncing_data"
+	assert.False(t, strictReady(t.Context(), syncing))
+
+	notResolvable := ready()
+	notResolvable.Components = append(notResolvable.Components, service.ComponentOut{Component: "kafka", Host: "bar.aivencloud.com"})
+	assert.False(t, strictReady(t.Context(), notResolvable))
+
+	// The public and dynamic routes must be resolvable
+	for _, route := range []service.RouteType{service.RouteTypePublic, service.RouteTypeDynamic} {
+		notResolvable = ready()
+		notResolvable.Components = append(notResolvable.Components, service.ComponentOut{Component: "kafka", Host: "public-bar.aivencloud.com", Route: route})
+		assert.False(t, strictReady(t.Context(), notResolvable), route)
+	}
+
+	// The private and privatelink routes resolve only inside the network, they aren't resolved
+	private := ready()
+	private.Components = append(private.Components,
+		service.ComponentOut{Component: "kafka", Host: "privatelink-bar.aivencloud.com", Route: service.RouteTypePrivatelink},
+		service.ComponentOut{Component: "kafka", Host: "private-bar.aivencloud.com", Route: service.RouteTypePrivate},
+	)
+	assert.True(t, strictReady(t.Context(), private))
+}
+
+func TestWaitForServiceMaintenance(t *testing.T) {
+	origDelay, origMinTimeout := maintenanceDelay, maintenanceMinTimeout
+	t.Cleanup(func() { maintenanceDelay, maintenanceMinTimeout = origDelay, origMinTimeout })
+	maintenanceDelay, maintenanceMinTimeout = 0, 0
+
+	newService := func(state string, updates ...string) *service.ServiceGetOut {
+		list := make([]map[string]any, 0, len(updates))
+		for _, u := range updates {
+			list = append(list, map[string]any{"description": u})
+		}
+
+		s := new(service.ServiceGetOut)
+		err := Remarshal(map[string]any{"state": state, "maintenance": map[string]any{"updates": list}}, s)
+		require.NoError(t, err)
+		return s
+	}
+
+	pending, err := FlattenServiceMaintenanceUpdates(newService("RUNNING", "Operating system update"))
+	require.NoError(t, err)
+
+	cases := []struct {
+		name     string
+		services []*service.ServiceGetOut
+	}{
+		{
+			name: "waits until the service leaves RUNNING and is RUNNING again",
+			services: []*service.ServiceGetOut{
+				newService("RUNNING", "Operating system update"),
+				newService("REBUILDING", "Operating system update"),
+				newService("REBALANCING"),
+				newService("RUNNING"),
+			},
+		},
+		{
+			name: "the updates are applied without changing the state",
+			services: []*service.ServiceGetOut{
+				newService("RUNNING", "Operating system update"),
+				newService("RUNNING"),
+			},
+		},
+	}
+
+	for _, opt := range cases {
+		t.Run(opt.name, func(t *testing.T) {
+			ctx := context.Background()
+			d := mocks.NewMockResourceData(t)
+			d.EXPECT().Get("project").Return("foo")
+			d.EXPECT().Get("service_name").Return("bar")
+			d.EXPECT().IsNewResource().Return(false)
+			d.EXPECT().Timeout(schema.TimeoutUpdate).Return(time.Minute)
+
+			client := avngen.NewMockClient(t)
+			for _, s := range opt.services {
+				client.EXPECT().ServiceGet(ctx, "foo", "bar").Return(s, nil).Once()
+			}
+
+			s, err := WaitForServiceMaintenance(ctx, d, client, pending)
+			require.NoError(t, err)
+			assert.Equal(t, service.ServiceStateTypeRunning, s.State)
+		})
+	}
+}
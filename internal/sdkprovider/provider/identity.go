@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
+)
+
+// addIdentity adds the resource identity to the listable resources, see listResourceSpecs.
+func addIdentity(m map[string]*schema.Resource) error {
+	for k, spec := range listResourceSpecs() {
+		r, ok := m[k]
+		if !ok {
+			return fmt.Errorf("listable resource %q not found", k)
+		}
+		withIdentity(r, spec.idFields)
+	}
+	return nil
+}
+
+// withIdentity makes the identity from the resource ID parts:
+// sets it after create, read and update, and imports the resource by the identity.
+func withIdentity(r *schema.Resource, idFields []string) {
+	r.Identity = &schema.ResourceIdentity{
+		SchemaFunc: func() map[string]*schema.Schema {
+			s := make(map[string]*schema.Schema, len(idFields))
+			for _, k := range idFields {
+				s[k] = &schema.Schema{
+					Type:              schema.TypeString,
+					RequiredForImport: true,
+					Description:       r.Schema[k].Description,
+				}
+			}
+			return s
+		},
+	}
+
+	r.CreateContext = withSetIdentity(r.CreateContext, idFields)
+	r.ReadContext = withSetIdentity(r.ReadContext, idFields)
+	r.UpdateContext = withSetIdentity(r.UpdateContext, idFields)
+
+	importState := r.Importer.StateContext
+	r.Importer.StateContext = func(ctx context.Context, d *schema.ResourceData, m any) ([]*schema.ResourceData, error) {
+		if d.Id() == "" {
+			identity, err := d.Identity()
+			if err != nil {
+				return nil, err
+			}
+
+			parts := make([]string, len(idFields))
+			for i, k := range idFields {
+				parts[i] = identity.Get(k).(string)
+			}
+			d.SetId(schemautil.BuildResourceID(parts...))
+		}
+		return importState(ctx, d, m)
+	}
+}
+
+func withSetIdentity(
+	f func(context.Context, *schema.ResourceData, any) diag.Diagnostics,
+	idFields []string,
+) func(context.Context, *schema.ResourceData, any) diag.Diagnostics {
+	if f == nil {
+		return nil
+	}
+
+	return func(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+		diags := f(ctx, d, m)
+		if diags.HasError() || d.Id() == "" {
+			// Either failed or the resource is gone
+			return diags
+		}
+		return append(diags, diag.FromErr(setIdentity(d, idFields))...)
+	}
+}
+
+// setIdentity sets the identity from the resource ID.
+func setIdentity(d *schema.ResourceData, idFields []string) error {
+	parts, err := schemautil.SplitResourceID(d.Id(), len(idFields))
+	if err != nil {
+		return err
+	}
+
+	identity, err := d.Identity()
+	if err != nil {
+		return err
+	}
+
+	for i, k := range idFields {
+		if err := identity.Set(k, parts[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithIdentity(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"project":      {Type: schema.TypeString, Required: true, Description: "Project name."},
+			"service_name": {Type: schema.TypeString, Required: true, Description: "Service name."},
+		},
+		ReadContext: func(_ context.Context, _ *schema.ResourceData, _ any) diag.Diagnostics {
+			return nil
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+
+	idFields := []string{"project", "service_name"}
+	withIdentity(r, idFields)
+	identitySchema := r.Identity.SchemaFunc()
+	require.Len(t, identitySchema, 2)
+	assert.True(t, identitySchema["project"].RequiredForImport)
+	assert.Equal(t, "Service name.", identitySchema["service_name"].Description)
+
+	// Imports by identity
+	d := schema.TestResourceDataWithIdentityRaw(t, r.Schema, identitySchema, map[string]string{
+		"project":      "foo",
+		"service_name": "bar",
+	})
+	result, err := r.Importer.StateContext(t.Context(), d, nil)
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	assert.Equal(t, "foo/bar", result[0].Id())
+
+	// Sets identity on read
+	d = schema.TestResourceDataWithIdentityRaw(t, r.Schema, identitySchema, map[string]string{})
+	d.SetId("baz/qux")
+	diags := r.ReadContext(t.Context(), d, nil)
+	require.False(t, diags.HasError())
+
+	identity, err := d.Identity()
+	require.NoError(t, err)
+	assert.Equal(t, "baz", identity.Get("project"))
+	assert.Equal(t, "qux", identity.Get("service_name"))
+}
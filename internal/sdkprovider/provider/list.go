@@ -0,0 +1,338 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+
+	"github.com/aiven/terraform-provider-aiven/internal/plugin/providerdata"
+	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
+)
+
+// listReadConcurrency limits parallel reads when "include_resource" is set.
+const listReadConcurrency = 10
+
+// listResourceSpec describes an SDK resource that can be listed with "terraform query".
+type listResourceSpec struct {
+	// idFields are the resource ID parts, they make the resource identity.
+	idFields []string
+
+	// list returns the resource IDs in the project.
+	list func(ctx context.Context, client avngen.Client, project string) ([]string, error)
+}
+
+func listResourceSpecs() map[string]listResourceSpec {
+	specs := map[string]listResourceSpec{
+		"aiven_service_integration": {
+			idFields: []string{"project", "integration_id"},
+			list:     listServiceIntegrations,
+		},
+	}
+
+	serviceTypes := []string{
+		schemautil.ServiceTypePG,
+		schemautil.ServiceTypeOpenSearch,
+		schemautil.ServiceTypeGrafana,
+		schemautil.ServiceTypeMySQL,
+		schemautil.ServiceTypeKafka,
+		schemautil.ServiceTypeKafkaConnect,
+		schemautil.ServiceTypeKafkaMirrormaker,
+		schemautil.ServiceTypeFlink,
+		schemautil.ServiceTypeClickhouse,
+		schemautil.ServiceTypeDragonfly,
+		schemautil.ServiceTypeThanos,
+		schemautil.ServiceTypeValkey,
+	}
+
+	for _, t := range serviceTypes {
+		specs["aiven_"+t] = listResourceSpec{
+			idFields: []string{"project", "service_name"},
+			list:     listServices(t),
+		}
+	}
+	return specs
+}
+
+func listServices(serviceType string) func(context.Context, avngen.Client, string) ([]string, error) {
+	return func(ctx context.Context, client avngen.Client, project string) ([]string, error) {
+		services, err := client.ServiceList(ctx, project)
+		if err != nil {
+			return nil, err
+		}
+
+		ids := make([]string, 0)
+		for _, s := range services {
+			if string(s.ServiceType) == serviceType {
+				ids = append(ids, schemautil.BuildResourceID(project, s.ServiceName))
+			}
+		}
+		return ids, nil
+	}
+}
+
+// listServiceIntegrations returns the integrations of all services in the project.
+// An integration belongs to both source and destination services, so the IDs are deduplicated.
+func listServiceIntegrations(ctx context.Context, client avngen.Client, project string) ([]string, error) {
+	services, err := client.ServiceList(ctx, project)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool)
+	ids := make([]string, 0)
+	for _, s := range services {
+		integrations, err := client.ServiceIntegrationList(ctx, project, s.ServiceName)
+		if err != nil {
+			return nil, fmt.Errorf("unable to list integrations of service %q: %w", s.ServiceName, err)
+		}
+
+		for _, i := range integrations {
+			if i.ServiceIntegrationId == "" || seen[i.ServiceIntegrationId] {
+				continue
+			}
+			seen[i.ServiceIntegrationId] = true
+			ids = append(ids, schemautil.BuildResourceID(project, i.ServiceIntegrationId))
+		}
+	}
+	return ids, nil
+}
+
+// ListResources returns the list resources for the SDK resources.
+// The list resources are served by the Plugin Framework provider,
+// but the resource schemas and reads come from the given (upgraded) SDK provider server.
+func ListResources(ctx context.Context, server tfprotov6.ProviderServer) ([]func() list.ListResource, error) {
+	schemas, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	identities, err := server.GetResourceIdentitySchemas(ctx, &tfprotov6.GetResourceIdentitySchemasRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]func() list.ListResource, 0)
+	for typeName, spec := range listResourceSpecs() {
+		s, ok := schemas.ResourceSchemas[typeName]
+		if !ok {
+			return nil, fmt.Errorf("schema of %q not found", typeName)
+		}
+
+		identity, ok := identities.IdentitySchemas[typeName]
+		if !ok {
+			return nil, fmt.Errorf("identity schema of %q not found", typeName)
+		}
+
+		result = append(result, func() list.ListResource {
+			return &sdkListResource{
+				typeName:       typeName,
+				spec:           spec,
+				server:         server,
+				schema:         s,
+				identitySchema: identity,
+			}
+		})
+	}
+	return result, nil
+}
+
+var (
+	_ list.ListResource                 = (*sdkListResource)(nil)
+	_ list.ListResourceWithConfigure    = (*sdkListResource)(nil)
+	_ list.ListResourceWithRawV6Schemas = (*sdkListResource)(nil)
+)
+
+type sdkListResource struct {
+	typeName       string
+	spec           listResourceSpec
+	server         tfprotov6.ProviderServer
+	schema         *tfprotov6.Schema
+	identitySchema *tfprotov6.ResourceIdentitySchema
+	client         avngen.Client
+}
+
+func (l *sdkListResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	rsp *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		// TF calls Configure many times, it might not contain the provider data yet.
+		return
+	}
+
+	p, diags := providerdata.FromRequest(req.ProviderData)
+	if diags.HasError() {
+		rsp.Diagnostics.Append(diags...)
+		return
+	}
+
+	l.client = p.GetGenClient()
+}
+
+func (l *sdkListResource) Metadata(
+	_ context.Context,
+	_ resource.MetadataRequest,
+	rsp *resource.MetadataResponse,
+) {
+	rsp.TypeName = l.typeName
+}
+
+func (l *sdkListResource) RawV6Schemas(
+	_ context.Context,
+	_ list.RawV6SchemaRequest,
+	rsp *list.RawV6SchemaResponse,
+) {
+	rsp.ProtoV6Schema = l.schema
+	rsp.ProtoV6IdentitySchema = l.identitySchema
+}
+
+func (l *sdkListResource) ListResourceConfigSchema(
+	_ context.Context,
+	_ list.ListResourceSchemaRequest,
+	rsp *list.ListResourceSchemaResponse,
+) {
+	rsp.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"project": listschema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the project to list the resources from.",
+			},
+		},
+		MarkdownDescription: fmt.Sprintf("Lists `%s` resources.", l.typeName),
+	}
+}
+
+func (l *sdkListResource) List(
+	ctx context.Context,
+	req list.ListRequest,
+	stream *list.ListResultsStream,
+) {
+	var project string
+	diags := req.Config.GetAttribute(ctx, path.Root("project"), &project)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	ids, err := l.spec.list(ctx, l.client, project)
+	if err != nil {
+		diags.AddError("failed to list resources", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	if req.Limit > 0 && int64(len(ids)) > req.Limit {
+		ids = ids[:req.Limit]
+	}
+
+	results := make([]list.ListResult, len(ids))
+	sem := make(chan struct{}, listReadConcurrency)
+	var wg sync.WaitGroup
+	for i, id := range ids {
+		wg.Go(func() {
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			results[i] = l.listResult(ctx, req, id)
+		})
+	}
+	wg.Wait()
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, r := range results {
+			if !push(r) {
+				return
+			}
+		}
+	}
+}
+
+// listResult builds the identity and, when requested, imports and reads the resource
+// with the SDK provider server, the same way "terraform import" does.
+func (l *sdkListResource) listResult(ctx context.Context, req list.ListRequest, id string) list.ListResult {
+	result := req.NewListResult(ctx)
+	result.DisplayName = id
+
+	parts, err := schemautil.SplitResourceID(id, len(l.spec.idFields))
+	if err != nil {
+		result.Diagnostics.AddError("invalid resource ID", err.Error())
+		return result
+	}
+
+	for i, k := range l.spec.idFields {
+		result.Diagnostics.Append(result.Identity.SetAttribute(ctx, path.Root(k), parts[i])...)
+	}
+
+	if !req.IncludeResource || result.Diagnostics.HasError() {
+		return result
+	}
+
+	imported, err := l.server.ImportResourceState(ctx, &tfprotov6.ImportResourceStateRequest{
+		TypeName: l.typeName,
+		ID:       id,
+	})
+	if err != nil {
+		result.Diagnostics.AddError("failed to import resource", err.Error())
+		return result
+	}
+
+	appendProtoDiags(&result.Diagnostics, imported.Diagnostics)
+	if result.Diagnostics.HasError() {
+		return result
+	}
+
+	if len(imported.ImportedResources) != 1 {
+		result.Diagnostics.AddError("failed to import resource", fmt.Sprintf("expected 1 imported resource, got %d", len(imported.ImportedResources)))
+		return result
+	}
+
+	state := imported.ImportedResources[0]
+	read, err := l.server.ReadResource(ctx, &tfprotov6.ReadResourceRequest{
+		TypeName:        l.typeName,
+		CurrentState:    state.State,
+		CurrentIdentity: state.Identity,
+		Private:         state.Private,
+	})
+	if err != nil {
+		result.Diagnostics.AddError("failed to read resource", err.Error())
+		return result
+	}
+
+	appendProtoDiags(&result.Diagnostics, read.Diagnostics)
+	if result.Diagnostics.HasError() {
+		return result
+	}
+
+	if read.NewState == nil {
+		result.Diagnostics.AddError("failed to read resource", fmt.Sprintf("resource %q not found", id))
+		return result
+	}
+
+	value, err := read.NewState.Unmarshal(l.schema.ValueType())
+	if err != nil {
+		result.Diagnostics.AddError("failed to read resource", err.Error())
+		return result
+	}
+
+	result.Resource.Raw = value
+	return result
+}
+
+// appendProtoDiags converts the SDK provider server diagnostics.
+func appendProtoDiags(target *diag.Diagnostics, diags []*tfprotov6.Diagnostic) {
+	for _, d := range diags {
+		if d.Severity == tfprotov6.DiagnosticSeverityError {
+			target.AddError(d.Summary, d.Detail)
+		} else {
+			target.AddWarning(d.Summary, d.Detail)
+		}
+	}
+}
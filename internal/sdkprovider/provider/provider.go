@@ -3,9 +3,9 @@ package provider
 import (
 	"context"
 	"fmt"
-	"os"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -25,6 +25,7 @@ import (
 	"github.com/aiven/terraform-provider-aiven/internal/sdkprovider/service/organization"
 	"github.com/aiven/terraform-provider-aiven/internal/sdkprovider/service/pg"
 	"github.com/aiven/terraform-provider-aiven/internal/sdkprovider/service/project"
+	"github.com/aiven/terraform-provider-aiven/internal/sdkprovider/service/service"
 	"github.com/aiven/terraform-provider-aiven/internal/sdkprovider/service/servicecomponent"
 	"github.com/aiven/terraform-provider-aiven/internal/sdkprovider/service/serviceintegration"
 	"github.com/aiven/terraform-provider-aiven/internal/sdkprovider/service/thanos"
@@ -43,20 +44,89 @@ func Provider(version string) (*schema.Provider, error) {
 				// Description should match the one in internal/plugin/provider.go.
 				Description: "Aiven authentication token. Can also be set with the AIVEN_TOKEN environment variable.",
 			},
+			// Descriptions below should match the ones in internal/plugin/provider.go.
+			"api_token_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"api_token", "api_token_command"},
+				Description: "Path to a file with the Aiven authentication token, for instance, rotated by a secret agent. " +
+					"The file is read again when the API rejects the token. " +
+					"Can also be set with the AIVEN_TOKEN_FILE environment variable.",
+			},
+			"api_token_command": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"api_token", "api_token_file"},
+				Description: "The command that prints the Aiven authentication token, " +
+					"the command and its arguments are separated by spaces. " +
+					"The command runs again when the API rejects the token. " +
+					"Can also be set with the AIVEN_TOKEN_COMMAND environment variable.",
+			},
+			"api_url": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Aiven API base URL, for instance, a local API stand-in for testing. " +
+					"Takes precedence over the AIVEN_WEB_URL environment variable, which the API clients read on their own.",
+			},
+			"max_retries": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Description: "The maximum number of retries of a failed API request. " +
+					"Can also be set with the AIVEN_MAX_RETRIES environment variable. The default value is `10`.",
+			},
+			"retry_backoff": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "The minimum wait time between retries, grows exponentially up to 30 seconds, " +
+					"for instance, `2s`. Can also be set with the AIVEN_RETRY_BACKOFF environment variable. " +
+					"The default value is `1s`.",
+			},
+			"request_timeout": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "The timeout of a single API request attempt, for instance, `1m`. " +
+					"Can also be set with the AIVEN_REQUEST_TIMEOUT environment variable.",
+			},
+			"http_proxy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "HTTP proxy URL for the API requests. " +
+					"Can also be set with the AIVEN_HTTP_PROXY environment variable.",
+			},
+			"rate_limit": {
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Description: "The client-side limit of API requests per second, including retries. " +
+					"Can also be set with the AIVEN_RATE_LIMIT environment variable.",
+			},
+			"cost_warning_threshold": {
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Description: "Shows a warning in the plan when a change increases the estimated monthly cost " +
+					"of a service by more than this amount in USD, for instance, `100`. " +
+					"See the `estimated_monthly_cost` of the service resources.",
+			},
+			"default_tags": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Description: "Tags that are added to all resources that support tags: services, projects and Kafka topics. " +
+					"The tags set in the resource take precedence.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tags": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Key-value pairs of the default tags.",
+						},
+					},
+				},
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
 			"aiven_service_component": servicecomponent.DatasourceServiceComponent(),
 
-			// grafana
-			"aiven_grafana": grafana.DatasourceGrafana(),
-
-			// mysql
-			"aiven_mysql": mysql.DatasourceMySQL(),
-
-			// pg
-			"aiven_pg": pg.DatasourcePG(),
-
 			// account
 			"aiven_account":                account.DatasourceAccount(),
 			"aiven_account_team":           account.DatasourceAccountTeam(),
@@ -85,36 +155,24 @@ func Provider(version string) (*schema.Provider, error) {
 			"aiven_service_integration_endpoint": serviceintegration.DatasourceServiceIntegrationEndpoint(),
 
 			// flink
-			"aiven_flink":                     flink.DatasourceFlink(),
 			"aiven_flink_application_version": flink.DatasourceFlinkApplicationVersion(),
 
 			// opensearch
-			"aiven_opensearch":            opensearch.DatasourceOpenSearch(),
 			"aiven_opensearch_acl_config": opensearch.DatasourceOpenSearchACLConfig(),
 			"aiven_opensearch_acl_rule":   opensearch.DatasourceOpenSearchACLRule(),
 
 			// kafka
-			"aiven_kafka":                      kafka.DatasourceKafka(),
 			"aiven_kafka_schema":               kafkaschema.DatasourceKafkaSchema(),
 			"aiven_kafka_schema_configuration": kafkaschema.DatasourceKafkaSchemaConfiguration(),
 			"aiven_kafka_connector":            kafka.DatasourceKafkaConnector(),
-			"aiven_kafka_connect":              kafka.DatasourceKafkaConnect(),
-			"aiven_kafka_mirrormaker":          kafka.DatasourceKafkaMirrormaker(),
-
-			// clickhouse
-			"aiven_clickhouse": clickhouse.DatasourceClickhouse(),
-
-			// dragonfly
-			"aiven_dragonfly": dragonfly.DatasourceDragonfly(),
-
-			// thanos
-			"aiven_thanos": thanos.DatasourceThanos(),
-
-			// valkey
-			"aiven_valkey": valkey.DatasourceValkey(),
+			"aiven_kafka_connector_plugins":    kafka.DatasourceKafkaConnectorPlugins(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
+			// service
+			"aiven_service":             service.ResourceService(),
+			"aiven_service_maintenance": service.ResourceServiceMaintenance(),
+
 			// grafana
 			"aiven_grafana": grafana.ResourceGrafana(),
 
@@ -192,6 +250,7 @@ func Provider(version string) (*schema.Provider, error) {
 
 	// Adds "beta" warning to the description
 	betaResources := []string{
+		"aiven_service",
 		"aiven_flink_jar_application",
 		"aiven_flink_jar_application_version",
 		"aiven_flink_jar_application_deployment",
@@ -230,8 +289,14 @@ func Provider(version string) (*schema.Provider, error) {
 		}
 	}
 
+	// Adds identity to the resources listed with "terraform query"
+	err := addIdentity(p.ResourcesMap)
+	if err != nil {
+		return nil, err
+	}
+
 	// Marks sensitive fields recursively
-	err := validateSensitive(p.ResourcesMap, false)
+	err = validateSensitive(p.ResourcesMap, false)
 	if err != nil {
 		return nil, fmt.Errorf("resource map error: %w", err)
 	}
@@ -245,26 +310,45 @@ func Provider(version string) (*schema.Provider, error) {
 	}
 
 	p.ConfigureContextFunc = func(_ context.Context, d *schema.ResourceData) (any, diag.Diagnostics) {
-		token := d.Get("api_token").(string)
-		if token == "" {
-			token = os.Getenv("AIVEN_TOKEN")
+		opts, err := httpClientOpts(d)
+		if err != nil {
+			return nil, diag.FromErr(err)
 		}
 
-		opts := []common.ClientOpt{
-			common.TokenOpt(token),
+		opts = append(
+			opts,
 			common.TFVersionOpt(p.TerraformVersion),
 			common.BuildVersionOpt(version),
+		)
+
+		// Unset token fields fall back to the environment variables
+		switch {
+		case d.Get("api_token").(string) != "":
+			opts = append(opts, common.TokenOpt(d.Get("api_token").(string)))
+		case d.Get("api_token_file").(string) != "":
+			opts = append(opts, common.TokenFileOpt(d.Get("api_token_file").(string)))
+		case d.Get("api_token_command").(string) != "":
+			opts = append(opts, common.TokenCommandOpt(d.Get("api_token_command").(string)))
+		}
+
+		common.SetDefaultTags(defaultTags(d))
+
+		// GetOk can't tell zero from unset
+		var costWarningThreshold *float64
+		if !d.GetRawConfig().GetAttr("cost_warning_threshold").IsNull() {
+			costWarningThreshold = new(d.Get("cost_warning_threshold").(float64))
 		}
+		common.SetCostWarningThreshold(costWarningThreshold)
 
 		client, err := common.NewAivenClient(opts...)
 		if err != nil {
-			return nil, diag.FromErr(err)
+			return nil, clientErrorDiag(err)
 		}
 
 		// fixme: temporary solution, uses a singleton
 		err = common.CachedGenAivenClient(opts...)
 		if err != nil {
-			return nil, diag.FromErr(err)
+			return nil, clientErrorDiag(err)
 		}
 
 		return client, nil
@@ -273,6 +357,75 @@ func Provider(version string) (*schema.Provider, error) {
 	return p, nil
 }
 
+// clientErrorDiag returns the same diagnostics as the Plugin Framework provider.
+func clientErrorDiag(err error) diag.Diagnostics {
+	summary, detail := common.ClientErrorMessage(err)
+	return diag.Diagnostics{{Severity: diag.Error, Summary: summary, Detail: detail}}
+}
+
+// httpClientOpts returns the client options for the set HTTP fields.
+// Unset fields fall back to the environment variables.
+func httpClientOpts(d *schema.ResourceData) ([]common.ClientOpt, error) {
+	var opts []common.ClientOpt
+	if v := d.Get("api_url").(string); v != "" {
+		u, err := common.ParseURL(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid api_url: %w", err)
+		}
+		opts = append(opts, common.APIURLOpt(u))
+	}
+
+	// GetOk can't tell zero from unset
+	if !d.GetRawConfig().GetAttr("max_retries").IsNull() {
+		opts = append(opts, common.MaxRetriesOpt(d.Get("max_retries").(int)))
+	}
+
+	if v := d.Get("retry_backoff").(string); v != "" {
+		dur, err := time.ParseDuration(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid retry_backoff: %w", err)
+		}
+		opts = append(opts, common.RetryBackoffOpt(dur))
+	}
+
+	if v := d.Get("request_timeout").(string); v != "" {
+		dur, err := time.ParseDuration(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid request_timeout: %w", err)
+		}
+		opts = append(opts, common.RequestTimeoutOpt(dur))
+	}
+
+	if v := d.Get("http_proxy").(string); v != "" {
+		u, err := common.ParseURL(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid http_proxy: %w", err)
+		}
+		opts = append(opts, common.HTTPProxyOpt(u))
+	}
+
+	if !d.GetRawConfig().GetAttr("rate_limit").IsNull() {
+		opts = append(opts, common.RateLimitOpt(d.Get("rate_limit").(float64)))
+	}
+	return opts, nil
+}
+
+// defaultTags returns the tags of the default_tags block.
+// The Plugin Framework provider validates that there is one block at most.
+func defaultTags(d *schema.ResourceData) map[string]string {
+	tags := make(map[string]string)
+	for _, block := range d.Get("default_tags").([]any) {
+		v, ok := block.(map[string]any)
+		if !ok {
+			continue
+		}
+		for k, value := range v["tags"].(map[string]any) {
+			tags[k] = value.(string)
+		}
+	}
+	return tags
+}
+
 // addBeta adds resources as beta or removes them
 func addBeta(m map[string]*schema.Resource, keys ...string) (missing []string) {
 	isBeta := util.IsBeta()
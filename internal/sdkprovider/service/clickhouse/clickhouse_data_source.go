@@ -8,7 +8,6 @@ import (
 
 func DatasourceClickhouse() *schema.Resource {
 	return &schema.Resource{
-		ReadContext: schemautil.DatasourceServiceRead,
 		Description: "Gets information about a ClickHouse service.",
 		Schema:      schemautil.ResourceSchemaAsDatasourceSchema(clickhouseSchema(), "project", "service_name"),
 	}
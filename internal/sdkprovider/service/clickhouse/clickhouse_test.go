@@ -124,3 +124,11 @@ func testAccCheckAivenServiceClickhouseAttributes(n string) resource.TestCheckFu
 		return nil
 	}
 }
+
+func TestAccAivenClickhousePasswordRotation(t *testing.T) {
+	acc.TestAccCheckAivenServiceWriteOnlyPassword(t, acc.ServicePasswordTestOptions{
+		ResourceType: "aiven_clickhouse",
+		Username:     "avnadmin",
+		Plan:         "startup-16", // ClickHouse has no startup-4 plan
+	})
+}
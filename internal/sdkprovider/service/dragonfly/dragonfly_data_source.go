@@ -8,7 +8,6 @@ import (
 
 func DatasourceDragonfly() *schema.Resource {
 	return &schema.Resource{
-		ReadContext: schemautil.DatasourceServiceRead,
 		Description: "Gets information about an Aiven for Dragonfly® service.",
 		Schema:      schemautil.ResourceSchemaAsDatasourceSchema(dragonflySchema(), "project", "service_name"),
 	}
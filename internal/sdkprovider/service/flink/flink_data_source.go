@@ -8,7 +8,6 @@ import (
 
 func DatasourceFlink() *schema.Resource {
 	return &schema.Resource{
-		ReadContext: schemautil.DatasourceServiceRead,
 		Description: "Gets information about an Aiven for Apache Flink® service.",
 		Schema:      schemautil.ResourceSchemaAsDatasourceSchema(aivenFlinkSchema(), "project", "service_name"),
 	}
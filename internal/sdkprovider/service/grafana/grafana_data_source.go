@@ -8,7 +8,6 @@ import (
 
 func DatasourceGrafana() *schema.Resource {
 	return &schema.Resource{
-		ReadContext: schemautil.DatasourceServiceRead,
 		Description: "Gets information about an Aiven for Grafana® service.",
 		Schema:      schemautil.ResourceSchemaAsDatasourceSchema(grafanaSchema(), "project", "service_name"),
 	}
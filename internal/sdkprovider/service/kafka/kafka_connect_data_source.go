@@ -8,7 +8,6 @@ import (
 
 func DatasourceKafkaConnect() *schema.Resource {
 	return &schema.Resource{
-		ReadContext: schemautil.DatasourceServiceRead,
 		Description: "Gets information about an Aiven for Apache Kafka® Connect service.",
 		Schema:      schemautil.ResourceSchemaAsDatasourceSchema(aivenKafkaConnectSchema(), "project", "service_name"),
 	}
@@ -5,14 +5,20 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	avngen "github.com/aiven/go-client-codegen"
 	"github.com/aiven/go-client-codegen/handler/kafkaconnect"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/aiven/terraform-provider-aiven/internal/common"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
@@ -34,7 +40,39 @@ var aivenKafkaConnectorSchema = map[string]*schema.Schema{
 		Elem: &schema.Schema{
 			Type: schema.TypeString,
 		},
-		Description: "The Kafka connector configuration parameters.",
+		Description: "The Kafka connector configuration parameters. " +
+			"Validated by the Kafka Connect validation endpoint of the `connector.class` plugin when planning, " +
+			"the errors are reported per parameter.",
+	},
+	"sensitive_config": {
+		Type:      schema.TypeMap,
+		Optional:  true,
+		Sensitive: true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+		Description: "The Kafka connector configuration parameters that are hidden in the plan output, for instance, passwords. " +
+			"Merged with `config`, the keys can't be in `config`. The values that the API returns redacted are kept from the state.",
+	},
+	"sensitive_config_wo": {
+		Type:      schema.TypeMap,
+		Optional:  true,
+		Sensitive: true,
+		WriteOnly: true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+		RequiredWith: []string{"sensitive_config_wo_version"},
+		Description: "The Kafka connector configuration parameters (write-only, not stored in state). Must be used with `sensitive_config_wo_version`. " +
+			"Merged with `config`, the keys can't be in `config` or `sensitive_config`. " +
+			"Changes to the configuration that aren't in `config` or `sensitive_config` aren't detected.",
+	},
+	"sensitive_config_wo_version": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		RequiredWith: []string{"sensitive_config_wo"},
+		ValidateFunc: validation.IntAtLeast(1),
+		Description:  "Version number for `sensitive_config_wo`. Increment this to update the write-only configuration. Must be >= 1.",
 	},
 	"plugin_author": {
 		Type:        schema.TypeString,
@@ -66,6 +104,21 @@ var aivenKafkaConnectorSchema = map[string]*schema.Schema{
 		Computed:    true,
 		Description: "The version of the Kafka connector.",
 	},
+	"state": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validation.StringInSlice(kafkaConnectorStates, false),
+		Description: userconfig.Desc("The state of the connector. The `paused` connector keeps its tasks, the `stopped` connector shuts them down. " +
+			"The failed connectors are `running`, see the `task` states.").PossibleValuesString(kafkaConnectorStates...).Build(),
+	},
+	"task_failure_check_period": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validateDuration,
+		Description: "Checks the tasks of the running connector for this period after create and update, for example, `2m`. " +
+			"Fails the apply when a task is `FAILED`.",
+	},
 	"task": {
 		Type:        schema.TypeSet,
 		Description: "List of tasks of a connector.",
@@ -82,11 +135,36 @@ var aivenKafkaConnectorSchema = map[string]*schema.Schema{
 					Description: "The task ID of the task.",
 					Computed:    true,
 				},
+				"state": {
+					Type:        schema.TypeString,
+					Description: "The state of the task, for instance, `RUNNING`, `PAUSED` or `FAILED`.",
+					Computed:    true,
+				},
+				"trace": {
+					Type:        schema.TypeString,
+					Description: "The error stack trace of the failed task.",
+					Computed:    true,
+				},
 			},
 		},
 	},
 }
 
+const (
+	kafkaConnectorStateRunning = "running"
+	kafkaConnectorStatePaused  = "paused"
+	kafkaConnectorStateStopped = "stopped"
+)
+
+var kafkaConnectorStates = []string{kafkaConnectorStateRunning, kafkaConnectorStatePaused, kafkaConnectorStateStopped}
+
+func validateDuration(v any, k string) ([]string, []error) {
+	if _, err := time.ParseDuration(v.(string)); err != nil {
+		return nil, []error{fmt.Errorf("%q must be a duration, for example, 2m: %w", k, err)}
+	}
+	return nil, nil
+}
+
 func ResourceKafkaConnector() *schema.Resource {
 	return &schema.Resource{
 		Description: `
@@ -105,13 +183,243 @@ You can use connectors with any Aiven for Apache Kafka® service that is integra
 		Timeouts: schemautil.DefaultResourceTimeouts(),
 
 		Schema: aivenKafkaConnectorSchema,
-		CustomizeDiff: customdiff.IfValueChange("config",
-			kafkaConnectorConfigNameShouldNotBeEmpty(),
-			customizeDiffKafkaConnectorConfigName(),
+		CustomizeDiff: customdiff.Sequence(
+			customdiff.IfValueChange("config",
+				kafkaConnectorConfigNameShouldNotBeEmpty(),
+				customizeDiffKafkaConnectorConfigName(),
+			),
+			customizeDiffKafkaConnectorSensitiveConfig,
+			customizeDiffKafkaConnectorValidateConfig,
+			schemautil.CustomizeDiffWriteOnlyVersion("sensitive_config_wo_version"),
 		),
 	}
 }
 
+// customizeDiffKafkaConnectorSensitiveConfig the sensitive keys can't be in `config`
+func customizeDiffKafkaConnectorSensitiveConfig(_ context.Context, diff *schema.ResourceDiff, _ any) error {
+	if !diff.NewValueKnown("config") || !diff.NewValueKnown("sensitive_config") {
+		return nil
+	}
+
+	config := diff.Get("config").(map[string]any)
+	sensitiveConfig := diff.Get("sensitive_config").(map[string]any)
+	for k := range sensitiveConfig {
+		if _, ok := config[k]; ok {
+			return fmt.Errorf("the key %q can't be in both config and sensitive_config", k)
+		}
+	}
+
+	woConfig := diff.GetRawConfig().GetAttr("sensitive_config_wo")
+	if !woConfig.IsKnown() || woConfig.IsNull() {
+		return nil
+	}
+
+	for k := range woConfig.AsValueMap() {
+		_, inConfig := config[k]
+		_, inSensitive := sensitiveConfig[k]
+		if inConfig || inSensitive {
+			return fmt.Errorf("the key %q can't be in both sensitive_config_wo and config or sensitive_config", k)
+		}
+	}
+	return nil
+}
+
+// customizeDiffKafkaConnectorValidateConfig validates the configuration with the Kafka Connect validation endpoint,
+// so the misconfigurations are reported when planning.
+// The errors are added to the plan per parameter, see common.AddPlanAttributeError.
+func customizeDiffKafkaConnectorValidateConfig(ctx context.Context, diff *schema.ResourceDiff, _ any) error {
+	if diff.Id() != "" && !diff.HasChanges("config", "sensitive_config", "sensitive_config_wo_version") {
+		return nil
+	}
+
+	if !diff.NewValueKnown("project") || !diff.NewValueKnown("service_name") {
+		return nil
+	}
+
+	// The keys are mapped to the fields they are set in, the unknown values are skipped
+	fields := make(map[string]string)
+	config := make(map[string]string)
+	for _, field := range []string{"config", "sensitive_config", "sensitive_config_wo"} {
+		m := diff.GetRawConfig().GetAttr(field)
+		if !m.IsKnown() {
+			// The required parameters can't be checked
+			return nil
+		}
+
+		if m.IsNull() {
+			continue
+		}
+
+		for k, v := range m.AsValueMap() {
+			fields[k] = field
+			if v.IsKnown() && !v.IsNull() {
+				config[k] = v.AsString()
+			}
+		}
+	}
+
+	class := config["connector.class"]
+	if class == "" {
+		return nil
+	}
+
+	client, err := common.GenClient()
+	if err != nil {
+		return err
+	}
+
+	project := diff.Get("project").(string)
+	serviceName := diff.Get("service_name").(string)
+	errs, err := validateKafkaConnectorConfig(ctx, client, project, serviceName, class, config)
+	if avngen.IsNotFound(err) {
+		// The service is not created yet
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("unable to validate the Kafka connector config: %w", err)
+	}
+
+	var count int
+	for _, k := range slices.Sorted(maps.Keys(errs)) {
+		field, ok := fields[k]
+		if !ok {
+			// A missing parameter
+			field = "config"
+		} else if _, known := config[k]; !known {
+			// The value is unknown yet
+			continue
+		}
+
+		for _, msg := range errs[k] {
+			count++
+			common.AddPlanAttributeError(ctx, field, k, "Invalid Kafka connector config", fmt.Sprintf("%s.%s: %s", field, k, msg))
+		}
+	}
+
+	if count > 0 {
+		return fmt.Errorf("the Kafka connector config has %d errors", count)
+	}
+	return nil
+}
+
+// validateKafkaConnectorConfig returns the errors per parameter of the config.
+// Calls the Kafka Connect validation endpoint, which runs the validators of the plugin class.
+// When the endpoint is not available, for instance, the service is in a private network,
+// checks the required parameters and the types of the values with the configuration parameters of the plugin.
+func validateKafkaConnectorConfig(ctx context.Context, client avngen.Client, project, serviceName, class string, config map[string]string) (map[string][]string, error) {
+	errs, err := validateKafkaConnectorConfigRemote(ctx, client, project, serviceName, class, config)
+	if err == nil || avngen.IsNotFound(err) {
+		return errs, err
+	}
+	tflog.Info(ctx, fmt.Sprintf("Kafka Connect validation endpoint is not available, validating with the plugin configuration: %s", err))
+
+	defs, err := getKafkaConnectorConfigDefs(ctx, client, project, serviceName, class)
+	if err != nil {
+		return nil, err
+	}
+	return validateKafkaConnectorConfigDefs(defs, config), nil
+}
+
+// validateKafkaConnectorConfigDefs returns an error per parameter that is missing or has a value of a wrong type.
+// The keys with unknown values are not in the config.
+func validateKafkaConnectorConfigDefs(defs []kafkaConnectorConfigDef, config map[string]string) map[string][]string {
+	errs := make(map[string][]string)
+	for _, def := range defs {
+		v, ok := config[def.Name]
+		if !ok {
+			if def.Required && def.DefaultValue == "" {
+				errs[def.Name] = append(errs[def.Name], "the parameter is required")
+			}
+			continue
+		}
+
+		if err := validateKafkaConnectorConfigValue(def.Type, v); err != nil {
+			errs[def.Name] = append(errs[def.Name], err.Error())
+		}
+	}
+	return errs
+}
+
+func validateKafkaConnectorConfigValue(valueType, v string) error {
+	var err error
+	switch valueType {
+	case "BOOLEAN":
+		if !strings.EqualFold(v, "true") && !strings.EqualFold(v, "false") {
+			err = fmt.Errorf("invalid syntax")
+		}
+	case "SHORT":
+		_, err = strconv.ParseInt(v, 10, 16)
+	case "INT":
+		_, err = strconv.ParseInt(v, 10, 32)
+	case "LONG":
+		_, err = strconv.ParseInt(v, 10, 64)
+	case "DOUBLE":
+		_, err = strconv.ParseFloat(v, 64)
+	}
+	if err != nil {
+		return fmt.Errorf("expected %s, got %q", valueType, v)
+	}
+	return nil
+}
+
+// expandKafkaConnectorConfig merges `config`, `sensitive_config` and `sensitive_config_wo`
+func expandKafkaConnectorConfig(d *schema.ResourceData) map[string]string {
+	config := make(map[string]string)
+	for _, key := range []string{"config", "sensitive_config"} {
+		for k, v := range d.Get(key).(map[string]any) {
+			config[k] = v.(string)
+		}
+	}
+
+	// we use GetRawConfig because sensitive_config_wo is WriteOnly, so it's not present in the state.
+	woConfig := d.GetRawConfig().GetAttr("sensitive_config_wo")
+	if woConfig.IsKnown() && !woConfig.IsNull() {
+		for k, v := range woConfig.AsValueMap() {
+			if v.IsKnown() && !v.IsNull() {
+				config[k] = v.AsString()
+			}
+		}
+	}
+	return config
+}
+
+// flattenKafkaConnectorConfig splits the API config into `config` and `sensitive_config`.
+// The API returns some secrets redacted, those are kept from the state.
+// When `sensitive_config_wo` is used, its keys are unknown, so only the keys that are in the state are set.
+// The data source doesn't have `sensitive_config` and `sensitive_config_wo_version`, it reads the whole config into `config`.
+func flattenKafkaConnectorConfig(d *schema.ResourceData, apiConfig map[string]string) (config, sensitiveConfig map[string]string) {
+	oldConfig, _ := d.Get("config").(map[string]any)
+	oldSensitiveConfig, _ := d.Get("sensitive_config").(map[string]any)
+	woVersion, _ := d.Get("sensitive_config_wo_version").(int)
+	hasWriteOnly := woVersion > 0
+
+	config = make(map[string]string)
+	sensitiveConfig = make(map[string]string)
+	for k, v := range apiConfig {
+		redacted := schemautil.ContainsRedactedCreds(map[string]any{k: v}) != nil
+		if old, ok := oldSensitiveConfig[k]; ok {
+			if redacted {
+				v = old.(string)
+			}
+			sensitiveConfig[k] = v
+			continue
+		}
+
+		old, ok := oldConfig[k]
+		switch {
+		case ok && redacted:
+			config[k] = old.(string)
+		case ok:
+			config[k] = v
+		case redacted, hasWriteOnly:
+			// Belongs to sensitive_config_wo, or can't be read
+		default:
+			config[k] = v
+		}
+	}
+	return config, sensitiveConfig
+}
+
 // customizeDiffKafkaConnectorConfigName `config.name` should be equal to `connector_name`
 func customizeDiffKafkaConnectorConfigName() func(ctx context.Context, diff *schema.ResourceDiff, i any) error {
 	return func(_ context.Context, diff *schema.ResourceDiff, _ any) error {
@@ -140,7 +448,7 @@ func kafkaConnectorConfigNameShouldNotBeEmpty() func(ctx context.Context, oldVal
 	}
 }
 
-func flattenKafkaConnectorTasks(tasks []kafkaconnect.TaskOut) []map[string]any {
+func flattenKafkaConnectorTasks(tasks []kafkaconnect.TaskOut, status *kafkaConnectorStatus) []map[string]any {
 	result := make([]map[string]any, len(tasks))
 
 	for i, taskS := range tasks {
@@ -149,12 +457,144 @@ func flattenKafkaConnectorTasks(tasks []kafkaconnect.TaskOut) []map[string]any {
 			"task":      taskS.Task,
 		}
 
+		if status != nil {
+			for _, t := range status.Tasks {
+				if t.ID == int(taskS.Task) {
+					task["state"] = t.State
+					task["trace"] = t.Trace
+				}
+			}
+		}
+
 		result[i] = task
 	}
 
 	return result
 }
 
+// kafkaConnectorStatus is the status of a connector, the API returns typed enums, so it is read as strings
+type kafkaConnectorStatus struct {
+	State string                     `json:"state"`
+	Tasks []kafkaConnectorTaskStatus `json:"tasks"`
+}
+
+type kafkaConnectorTaskStatus struct {
+	ID    int    `json:"id"`
+	State string `json:"state"`
+	Trace string `json:"trace"`
+}
+
+// desiredState returns the state argument: a failed or unassigned connector is still running
+func (s *kafkaConnectorStatus) desiredState() string {
+	switch state := strings.ToLower(s.State); state {
+	case kafkaConnectorStatePaused, kafkaConnectorStateStopped:
+		return state
+	}
+	return kafkaConnectorStateRunning
+}
+
+// failedTasksError returns the traces of the failed tasks
+func (s *kafkaConnectorStatus) failedTasksError(connectorName string) error {
+	var errs []error
+	for _, t := range s.Tasks {
+		if t.State == "FAILED" {
+			errs = append(errs, fmt.Errorf("task %d of the connector %q is FAILED: %s", t.ID, connectorName, t.Trace))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func getKafkaConnectorStatus(ctx context.Context, client avngen.Client, project, serviceName, connectorName string) (*kafkaConnectorStatus, error) {
+	out, err := client.ServiceKafkaConnectGetConnectorStatus(ctx, project, serviceName, connectorName)
+	if err != nil {
+		return nil, err
+	}
+
+	status := new(kafkaConnectorStatus)
+	if err := schemautil.Remarshal(out, status); err != nil {
+		return nil, fmt.Errorf("cannot read Kafka connector status: %w", err)
+	}
+	return status, nil
+}
+
+// setKafkaConnectorState pauses, stops or resumes the connector and waits for the state
+func setKafkaConnectorState(ctx context.Context, d *schema.ResourceData, client avngen.Client, timeout time.Duration) error {
+	project := d.Get("project").(string)
+	serviceName := d.Get("service_name").(string)
+	connectorName := d.Get("connector_name").(string)
+	state := d.Get("state").(string)
+
+	var err error
+	switch state {
+	case kafkaConnectorStatePaused:
+		err = client.ServiceKafkaConnectPauseConnector(ctx, project, serviceName, connectorName)
+	case kafkaConnectorStateStopped:
+		err = client.ServiceKafkaConnectStopConnector(ctx, project, serviceName, connectorName)
+	default:
+		err = client.ServiceKafkaConnectResumeConnector(ctx, project, serviceName, connectorName)
+	}
+	if err != nil {
+		return err
+	}
+
+	stateChangeConf := &retry.StateChangeConf{
+		Pending: []string{"IN_PROGRESS"},
+		Target:  []string{"OK"},
+		Refresh: func() (any, string, error) {
+			status, err := getKafkaConnectorStatus(ctx, client, project, serviceName, connectorName)
+			if err != nil {
+				return nil, "", err
+			}
+
+			if status.desiredState() != state {
+				return status, "IN_PROGRESS", nil
+			}
+			return status, "OK", nil
+		},
+		Delay:      common.DefaultStateChangeDelay,
+		Timeout:    timeout,
+		MinTimeout: common.DefaultStateChangeMinTimeout,
+	}
+
+	_, err = stateChangeConf.WaitForStateContext(ctx)
+	return err
+}
+
+// waitKafkaConnectorTasks checks the tasks of the running connector for the task_failure_check_period,
+// returns an error when a task is FAILED
+func waitKafkaConnectorTasks(ctx context.Context, d *schema.ResourceData, client avngen.Client) error {
+	period, _ := time.ParseDuration(d.Get("task_failure_check_period").(string))
+	state := d.Get("state").(string)
+	if period == 0 || state == kafkaConnectorStatePaused || state == kafkaConnectorStateStopped {
+		return nil
+	}
+
+	project := d.Get("project").(string)
+	serviceName := d.Get("service_name").(string)
+	connectorName := d.Get("connector_name").(string)
+	deadline := time.Now().Add(period)
+	for {
+		status, err := getKafkaConnectorStatus(ctx, client, project, serviceName, connectorName)
+		if err != nil {
+			return err
+		}
+
+		if err := status.failedTasksError(connectorName); err != nil {
+			return err
+		}
+
+		if time.Now().After(deadline) {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(common.DefaultStateChangeDelay):
+		}
+	}
+}
+
 func resourceKafkaConnectorRead(ctx context.Context, d *schema.ResourceData, client avngen.Client) diag.Diagnostics {
 	project, serviceName, connectorName, err := schemautil.SplitResourceID3(d.Id())
 	if err != nil {
@@ -179,9 +619,21 @@ func resourceKafkaConnectorRead(ctx context.Context, d *schema.ResourceData, cli
 			if err := d.Set("connector_name", connectorName); err != nil {
 				return diag.Errorf("error setting Kafka Connector `connector_name` for resource %s: %s", d.Id(), err)
 			}
-			if err := d.Set("config", r.Config); err != nil {
+			apiConfig := make(map[string]string)
+			if err := schemautil.Remarshal(r.Config, &apiConfig); err != nil {
+				return diag.Errorf("error reading Kafka Connector `config` for resource %s: %s", d.Id(), err)
+			}
+
+			config, sensitiveConfig := flattenKafkaConnectorConfig(d, apiConfig)
+			if err := d.Set("config", config); err != nil {
 				return diag.Errorf("error setting Kafka Connector `config` for resource %s: %s", d.Id(), err)
 			}
+			if _, ok := d.Get("sensitive_config").(map[string]any); ok {
+				// The data source doesn't have the field
+				if err := d.Set("sensitive_config", sensitiveConfig); err != nil {
+					return diag.Errorf("error setting Kafka Connector `sensitive_config` for resource %s: %s", d.Id(), err)
+				}
+			}
 			if err := d.Set("plugin_author", r.Plugin.Author); err != nil {
 				return diag.Errorf("error setting Kafka Connector `plugin_author` for resource %s: %s", d.Id(), err)
 			}
@@ -201,7 +653,18 @@ func resourceKafkaConnectorRead(ctx context.Context, d *schema.ResourceData, cli
 				return diag.Errorf("error setting Kafka Connector `plugin_version` for resource %s: %s", d.Id(), err)
 			}
 
-			tasks := flattenKafkaConnectorTasks(r.Tasks)
+			status, err := getKafkaConnectorStatus(ctx, client, project, serviceName, connectorName)
+			if common.IsCritical(err) {
+				return diag.Errorf("error getting Kafka Connector status for resource %s: %s", d.Id(), err)
+			}
+
+			if status != nil {
+				if err := d.Set("state", status.desiredState()); err != nil {
+					return diag.Errorf("error setting Kafka Connector `state` for resource %s: %s", d.Id(), err)
+				}
+			}
+
+			tasks := flattenKafkaConnectorTasks(r.Tasks, status)
 			if err := d.Set("task", tasks); err != nil {
 				return diag.Errorf("error setting Kafka Connector `task` array for resource %s: %s", d.Id(), err)
 			}
@@ -223,11 +686,7 @@ func resourceKafkaConnectorCreate(ctx context.Context, d *schema.ResourceData, c
 	serviceName := d.Get("service_name").(string)
 	connectorName := d.Get("connector_name").(string)
 
-	configRaw := d.Get("config").(map[string]any)
-	config := make(map[string]string, len(configRaw))
-	for k, v := range configRaw {
-		config[k] = v.(string)
-	}
+	config := expandKafkaConnectorConfig(d)
 
 	// Sometimes this method returns 404: Not Found for the given serviceName
 	// Since the client has its own retries for various scenarios
@@ -286,6 +745,17 @@ func resourceKafkaConnectorCreate(ctx context.Context, d *schema.ResourceData, c
 		return diag.Errorf("error waiting for Kafka Connector to be created: %s", err)
 	}
 
+	// A new connector is running
+	if state := d.Get("state").(string); state != "" && state != kafkaConnectorStateRunning {
+		if err := setKafkaConnectorState(ctx, d, client, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.Errorf("error changing Kafka Connector state to %s: %s", state, err)
+		}
+	}
+
+	if err := waitKafkaConnectorTasks(ctx, d, client); err != nil {
+		return diag.Errorf("error checking Kafka Connector tasks: %s", err)
+	}
+
 	return resourceKafkaConnectorRead(ctx, d, client)
 }
 
@@ -309,15 +779,23 @@ func resourceKafkaTConnectorUpdate(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
-	configRaw := d.Get("config").(map[string]any)
-	config := make(map[string]string, len(configRaw))
-	for k, v := range configRaw {
-		config[k] = v.(string)
+	config := expandKafkaConnectorConfig(d)
+
+	if d.HasChanges("config", "sensitive_config", "sensitive_config_wo_version") {
+		_, err = client.ServiceKafkaConnectEditConnector(ctx, project, serviceName, connectorName, &config)
+		if err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
-	_, err = client.ServiceKafkaConnectEditConnector(ctx, project, serviceName, connectorName, &config)
-	if err != nil {
-		return diag.FromErr(err)
+	if d.HasChange("state") {
+		if err := setKafkaConnectorState(ctx, d, client, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("error changing Kafka Connector state to %s: %s", d.Get("state"), err)
+		}
+	}
+
+	if err := waitKafkaConnectorTasks(ctx, d, client); err != nil {
+		return diag.Errorf("error checking Kafka Connector tasks: %s", err)
 	}
 
 	return resourceKafkaConnectorRead(ctx, d, client)
@@ -0,0 +1,87 @@
+package kafka
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlattenKafkaConnectorConfig(t *testing.T) {
+	cases := []struct {
+		name                    string
+		state                   map[string]any
+		apiConfig               map[string]string
+		expectedConfig          map[string]string
+		expectedSensitiveConfig map[string]string
+	}{
+		{
+			name: "plain values",
+			state: map[string]any{
+				"config": map[string]any{"name": "foo", "batch.size": "100"},
+			},
+			apiConfig:               map[string]string{"name": "foo", "batch.size": "200", "tasks.max": "1"},
+			expectedConfig:          map[string]string{"name": "foo", "batch.size": "200", "tasks.max": "1"},
+			expectedSensitiveConfig: map[string]string{},
+		},
+		{
+			name: "redacted values are kept from the state",
+			state: map[string]any{
+				"config":           map[string]any{"name": "foo", "connection.password": "secret"},
+				"sensitive_config": map[string]any{"connection.username": "admin"},
+			},
+			apiConfig: map[string]string{
+				"name":                "foo",
+				"connection.password": "<redacted>",
+				"connection.username": "<redacted>",
+			},
+			expectedConfig:          map[string]string{"name": "foo", "connection.password": "secret"},
+			expectedSensitiveConfig: map[string]string{"connection.username": "admin"},
+		},
+		{
+			name: "plain values of sensitive_config",
+			state: map[string]any{
+				"config":           map[string]any{"name": "foo"},
+				"sensitive_config": map[string]any{"connection.url": "https://old"},
+			},
+			apiConfig:               map[string]string{"name": "foo", "connection.url": "https://new"},
+			expectedConfig:          map[string]string{"name": "foo"},
+			expectedSensitiveConfig: map[string]string{"connection.url": "https://new"},
+		},
+		{
+			name: "unknown redacted values are dropped",
+			state: map[string]any{
+				"config": map[string]any{"name": "foo"},
+			},
+			apiConfig:               map[string]string{"name": "foo", "connection.password": "<redacted>"},
+			expectedConfig:          map[string]string{"name": "foo"},
+			expectedSensitiveConfig: map[string]string{},
+		},
+		{
+			name: "unknown keys belong to sensitive_config_wo",
+			state: map[string]any{
+				"config":                      map[string]any{"name": "foo", "batch.size": "100"},
+				"sensitive_config":            map[string]any{"connection.username": "admin"},
+				"sensitive_config_wo_version": 1,
+			},
+			apiConfig: map[string]string{
+				"name":                "foo",
+				"batch.size":          "100",
+				"connection.username": "admin",
+				"connection.password": "<redacted>",
+				"connection.url":      "https://foo",
+			},
+			expectedConfig:          map[string]string{"name": "foo", "batch.size": "100"},
+			expectedSensitiveConfig: map[string]string{"connection.username": "admin"},
+		},
+	}
+
+	for _, opt := range cases {
+		t.Run(opt.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, aivenKafkaConnectorSchema, opt.state)
+			config, sensitiveConfig := flattenKafkaConnectorConfig(d, opt.apiConfig)
+			assert.Equal(t, opt.expectedConfig, config)
+			assert.Equal(t, opt.expectedSensitiveConfig, sensitiveConfig)
+		})
+	}
+}
@@ -12,11 +12,19 @@ import (
 )
 
 func DatasourceKafkaConnector() *schema.Resource {
+	s := schemautil.ResourceSchemaAsDatasourceSchema(aivenKafkaConnectorSchema,
+		"project", "service_name", "connector_name")
+
+	// Applies to create and update only
+	delete(s, "task_failure_check_period")
+
+	// The data source reads the whole configuration into config
+	delete(s, "sensitive_config")
+
 	return &schema.Resource{
 		ReadContext: common.WithGenClientDiag(datasourceKafkaConnectorRead),
 		Description: "Gets information about an Aiven for Apache Kafka® connector.",
-		Schema: schemautil.ResourceSchemaAsDatasourceSchema(aivenKafkaConnectorSchema,
-			"project", "service_name", "connector_name"),
+		Schema:      s,
 	}
 }
 
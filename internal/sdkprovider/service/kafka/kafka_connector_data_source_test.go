@@ -0,0 +1,61 @@
+package kafka
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"github.com/aiven/go-client-codegen/handler/kafkaconnect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestDatasourceKafkaConnectorRead the data source shares the read with the resource,
+// but doesn't have the sensitive_config and sensitive_config_wo_version fields
+func TestDatasourceKafkaConnectorRead(t *testing.T) {
+	ctx := context.Background()
+
+	connectors := new(kafkaconnect.ServiceKafkaConnectListOut)
+	err := json.Unmarshal([]byte(`{
+		"connectors": [{
+			"name": "baz",
+			"config": {
+				"name": "baz",
+				"connector.class": "io.aiven.kafka.connect.opensearch.OpensearchSinkConnector",
+				"connection.password": "<redacted>"
+			},
+			"plugin": {"class": "io.aiven.kafka.connect.opensearch.OpensearchSinkConnector", "type": "sink"},
+			"tasks": [{"connector": "baz", "task": 0}]
+		}]
+	}`), connectors)
+	require.NoError(t, err)
+
+	status := new(kafkaconnect.ServiceKafkaConnectGetConnectorStatusOut)
+	err = json.Unmarshal([]byte(`{"state": "RUNNING", "tasks": [{"id": 0, "state": "RUNNING"}]}`), status)
+	require.NoError(t, err)
+
+	client := avngen.NewMockClient(t)
+	client.EXPECT().ServiceKafkaConnectList(ctx, "foo", "bar").Return(connectors, nil).Twice()
+	client.EXPECT().ServiceKafkaConnectGetConnectorStatus(ctx, "foo", "bar", "baz").Return(status, nil).Once()
+
+	r := DatasourceKafkaConnector()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]any{
+		"project":        "foo",
+		"service_name":   "bar",
+		"connector_name": "baz",
+	})
+
+	diags := datasourceKafkaConnectorRead(ctx, d, client)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, "foo/bar/baz", d.Id())
+	assert.Equal(t, "running", d.Get("state"))
+
+	// The redacted values can't be read
+	expected := map[string]any{
+		"name":            "baz",
+		"connector.class": "io.aiven.kafka.connect.opensearch.OpensearchSinkConnector",
+	}
+	assert.Equal(t, expected, d.Get("config"))
+}
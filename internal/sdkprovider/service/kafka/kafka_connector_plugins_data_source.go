@@ -0,0 +1,218 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/aiven/terraform-provider-aiven/internal/common"
+	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
+)
+
+func DatasourceKafkaConnectorPlugins() *schema.Resource {
+	return &schema.Resource{
+		Description: "Gets the connector plugins that are available in an Aiven for Apache Kafka® Connect service " +
+			"or an Aiven for Apache Kafka® service with Kafka Connect enabled, and their configuration parameters.",
+		ReadContext: common.WithGenClientDiag(datasourceKafkaConnectorPluginsRead),
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the project the service belongs to.",
+			},
+			"service_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the Kafka Connect service or the Kafka service with Kafka Connect enabled.",
+			},
+			"plugins": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The available connector plugins.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"author": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The connector author.",
+						},
+						"class": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The connector Java class, the value of `config.connector.class` in `aiven_kafka_connector`.",
+						},
+						"doc_url": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The connector documentation URL.",
+						},
+						"title": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The connector title.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The connector type, for instance, `source` or `sink`.",
+						},
+						"version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The version of the connector.",
+						},
+						"config": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The configuration parameters of the connector.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The parameter name.",
+									},
+									"display_name": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The human-readable parameter name.",
+									},
+									"documentation": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The parameter description.",
+									},
+									"type": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The parameter type, for instance, `STRING`, `INT` or `PASSWORD`.",
+									},
+									"default_value": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The default value.",
+									},
+									"required": {
+										Type:        schema.TypeBool,
+										Computed:    true,
+										Description: "Whether the parameter is required.",
+									},
+									"importance": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The parameter importance: `HIGH`, `MEDIUM` or `LOW`.",
+									},
+									"group": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The group of the parameter.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// kafkaConnectorPlugin is a connector plugin, the API returns typed enums, so it is read as strings
+type kafkaConnectorPlugin struct {
+	Author  string `json:"author"`
+	Class   string `json:"class"`
+	DocURL  string `json:"docURL"`
+	Title   string `json:"title"`
+	Type    string `json:"type"`
+	Version string `json:"version"`
+}
+
+// kafkaConnectorConfigDef is a configuration parameter definition of a connector plugin
+type kafkaConnectorConfigDef struct {
+	Name          string `json:"name"`
+	DisplayName   string `json:"display_name"`
+	Documentation string `json:"documentation"`
+	Type          string `json:"type"`
+	DefaultValue  string `json:"default_value"`
+	Required      bool   `json:"required"`
+	Importance    string `json:"importance"`
+	Group         string `json:"group"`
+}
+
+func getKafkaConnectorPlugins(ctx context.Context, client avngen.Client, project, serviceName string) ([]kafkaConnectorPlugin, error) {
+	out, err := client.ServiceKafkaConnectGetAvailableConnectors(ctx, project, serviceName)
+	if err != nil {
+		return nil, err
+	}
+
+	var plugins []kafkaConnectorPlugin
+	if err := schemautil.Remarshal(out, &plugins); err != nil {
+		return nil, fmt.Errorf("cannot read Kafka connector plugins: %w", err)
+	}
+	return plugins, nil
+}
+
+// getKafkaConnectorConfigDefs returns the configuration parameters of the plugin class
+func getKafkaConnectorConfigDefs(ctx context.Context, client avngen.Client, project, serviceName, class string) ([]kafkaConnectorConfigDef, error) {
+	out, err := client.ServiceKafkaConnectGetConnectorConfiguration(ctx, project, serviceName, class)
+	if err != nil {
+		return nil, err
+	}
+
+	var defs []kafkaConnectorConfigDef
+	if err := schemautil.Remarshal(out, &defs); err != nil {
+		return nil, fmt.Errorf("cannot read Kafka connector configuration of %s: %w", class, err)
+	}
+	return defs, nil
+}
+
+func datasourceKafkaConnectorPluginsRead(ctx context.Context, d *schema.ResourceData, client avngen.Client) diag.Diagnostics {
+	projectName := d.Get("project").(string)
+	serviceName := d.Get("service_name").(string)
+
+	plugins, err := getKafkaConnectorPlugins(ctx, client, projectName, serviceName)
+	if err != nil {
+		return diag.Errorf("error getting Kafka connector plugins: %s", err)
+	}
+
+	result := make([]map[string]any, 0, len(plugins))
+	for _, p := range plugins {
+		defs, err := getKafkaConnectorConfigDefs(ctx, client, projectName, serviceName, p.Class)
+		if err != nil {
+			return diag.Errorf("error getting Kafka connector plugin %s configuration: %s", p.Class, err)
+		}
+
+		config := make([]map[string]any, 0, len(defs))
+		for _, def := range defs {
+			config = append(config, map[string]any{
+				"name":          def.Name,
+				"display_name":  def.DisplayName,
+				"documentation": def.Documentation,
+				"type":          def.Type,
+				"default_value": def.DefaultValue,
+				"required":      def.Required,
+				"importance":    def.Importance,
+				"group":         def.Group,
+			})
+		}
+
+		result = append(result, map[string]any{
+			"author":  p.Author,
+			"class":   p.Class,
+			"doc_url": p.DocURL,
+			"title":   p.Title,
+			"type":    p.Type,
+			"version": p.Version,
+			"config":  config,
+		})
+	}
+
+	d.SetId(schemautil.BuildResourceID(projectName, serviceName))
+	if err := d.Set("plugins", result); err != nil {
+		return diag.Errorf("error setting Kafka connector `plugins`: %s", err)
+	}
+	return nil
+}
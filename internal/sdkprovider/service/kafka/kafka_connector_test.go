@@ -10,6 +10,7 @@ import (
 	avngen "github.com/aiven/go-client-codegen"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 
 	acc "github.com/aiven/terraform-provider-aiven/internal/acctest"
@@ -32,6 +33,12 @@ func TestAccAivenKafkaConnector_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "project", acc.ProjectName()),
 					resource.TestCheckResourceAttr(resourceName, "service_name", fmt.Sprintf("test-acc-sr-%s", rName)),
 					resource.TestCheckResourceAttr(resourceName, "connector_name", fmt.Sprintf("test-acc-con-%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "state", "running"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "task.*", map[string]string{"state": "RUNNING"}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.aiven_kafka_connector_plugins.plugins", "plugins.*", map[string]string{
+						"class": "io.aiven.kafka.connect.opensearch.OpensearchSinkConnector",
+						"type":  "sink",
+					}),
 				),
 			},
 			{
@@ -39,19 +46,58 @@ func TestAccAivenKafkaConnector_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(resourceName, "config.type.name", "es-connector-updated"),
 					resource.TestCheckResourceAttr(resourceName, "connector_name", fmt.Sprintf("test-acc-con-%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "state", "paused"),
+					resource.TestCheckResourceAttrPair(resourceName, "sensitive_config.connection.username", "aiven_opensearch.dest", "service_username"),
+					resource.TestCheckNoResourceAttr(resourceName, "config.connection.username"),
 				),
 			},
 			{
-				ResourceName:            resourceName,
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{},
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"task_failure_check_period",
+					// The imported connector has no sensitive keys
+					"sensitive_config",
+					"config.connection.username",
+					"config.connection.password",
+				},
+			},
+			{
+				// Moves the password to sensitive_config_wo
+				Config: testAccKafkaConnectorResourceWriteOnly(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "sensitive_config_wo_version", "1"),
+					resource.TestCheckNoResourceAttr(resourceName, "sensitive_config_wo"),
+					resource.TestCheckResourceAttrPair(resourceName, "sensitive_config.connection.username", "aiven_opensearch.dest", "service_username"),
+					resource.TestCheckNoResourceAttr(resourceName, "sensitive_config.connection.password"),
+					resource.TestCheckNoResourceAttr(resourceName, "config.connection.password"),
+				),
+			},
+			{
+				// Bumping the version sends sensitive_config_wo again
+				Config: testAccKafkaConnectorResourceWriteOnly(rName, 2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "sensitive_config_wo_version", "2"),
+					resource.TestCheckResourceAttr(resourceName, "state", "paused"),
+					resource.TestCheckNoResourceAttr(resourceName, "config.connection.password"),
+				),
 			},
 			{
 				Config:      testAccKafkaConnectorWrongConfigNameResource(rName),
 				PlanOnly:    true,
 				ExpectError: regexp.MustCompile("config.name should be equal to the connector_name"),
 			},
+			{
+				Config:      testAccKafkaConnectorInvalidConfigResource(rName),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`config.batch.size: (Invalid value many|expected INT, got "many")`),
+			},
 		},
 	})
 }
@@ -173,6 +219,8 @@ resource "aiven_kafka_connector" "foo" {
   service_name   = aiven_kafka.bar.service_name
   connector_name = "test-acc-con-%s"
 
+  task_failure_check_period = "30s"
+
   config = {
     "topics"          = aiven_kafka_topic.foo.topic_name
     "connector.class" = "io.aiven.kafka.connect.opensearch.OpensearchSinkConnector"
@@ -187,6 +235,13 @@ data "aiven_kafka_connector" "connector" {
   service_name   = aiven_kafka_connector.foo.service_name
   connector_name = aiven_kafka_connector.foo.connector_name
 
+  depends_on = [aiven_kafka_connector.foo]
+}
+
+data "aiven_kafka_connector_plugins" "plugins" {
+  project      = aiven_kafka.bar.project
+  service_name = aiven_kafka.bar.service_name
+
   depends_on = [aiven_kafka_connector.foo]
 }`, acc.ProjectName(), name, name, name, name, name)
 }
@@ -236,6 +291,7 @@ resource "aiven_kafka_connector" "foo" {
   project        = data.aiven_project.foo.project
   service_name   = aiven_kafka.bar.service_name
   connector_name = "test-acc-con-%s"
+  state          = "paused"
 
   config = {
     "topics"          = aiven_kafka_topic.foo.topic_name
@@ -244,6 +300,11 @@ resource "aiven_kafka_connector" "foo" {
     "name"            = "test-acc-con-%s"
     "connection.url"  = aiven_opensearch.dest.service_uri
   }
+
+  sensitive_config = {
+    "connection.username" = aiven_opensearch.dest.service_username
+    "connection.password" = aiven_opensearch.dest.service_password
+  }
 }
 
 data "aiven_kafka_connector" "connector" {
@@ -255,6 +316,72 @@ data "aiven_kafka_connector" "connector" {
 }`, acc.ProjectName(), name, name, name, name, name)
 }
 
+func testAccKafkaConnectorResourceWriteOnly(name string, version int) string {
+	return fmt.Sprintf(`
+data "aiven_project" "foo" {
+  project = "%[1]s"
+}
+
+resource "aiven_kafka" "bar" {
+  project                 = data.aiven_project.foo.project
+  cloud_name              = "google-europe-west1"
+  plan                    = "business-4"
+  service_name            = "test-acc-sr-%[2]s"
+  maintenance_window_dow  = "monday"
+  maintenance_window_time = "10:00:00"
+
+  kafka_user_config {
+    kafka_connect = true
+
+    kafka {
+      group_max_session_timeout_ms = 70000
+      log_retention_bytes          = 1000000000
+    }
+  }
+}
+
+resource "aiven_kafka_topic" "foo" {
+  project      = data.aiven_project.foo.project
+  service_name = aiven_kafka.bar.service_name
+  topic_name   = "test-acc-topic-%[2]s"
+  partitions   = 3
+  replication  = 2
+}
+
+resource "aiven_opensearch" "dest" {
+  project                 = data.aiven_project.foo.project
+  cloud_name              = "google-europe-west1"
+  plan                    = "startup-4"
+  service_name            = "test-acc-sr2-%[2]s"
+  maintenance_window_dow  = "monday"
+  maintenance_window_time = "10:00:00"
+}
+
+resource "aiven_kafka_connector" "foo" {
+  project        = data.aiven_project.foo.project
+  service_name   = aiven_kafka.bar.service_name
+  connector_name = "test-acc-con-%[2]s"
+  state          = "paused"
+
+  config = {
+    "topics"          = aiven_kafka_topic.foo.topic_name
+    "connector.class" = "io.aiven.kafka.connect.opensearch.OpensearchSinkConnector"
+    "type.name"       = "es-connector-updated"
+    "name"            = "test-acc-con-%[2]s"
+    "connection.url"  = aiven_opensearch.dest.service_uri
+  }
+
+  sensitive_config = {
+    "connection.username" = aiven_opensearch.dest.service_username
+  }
+
+  sensitive_config_wo = {
+    "connection.password" = aiven_opensearch.dest.service_password
+  }
+  sensitive_config_wo_version = %[3]d
+}`, acc.ProjectName(), name, version)
+}
+
 func testAccKafkaConnectorWrongConfigNameResource(name string) string {
 	return fmt.Sprintf(`
 data "aiven_project" "foo" {
@@ -319,6 +446,71 @@ data "aiven_kafka_connector" "connector" {
 }`, acc.ProjectName(), name, name, name, name, name)
 }
 
+func testAccKafkaConnectorInvalidConfigResource(name string) string {
+	return fmt.Sprintf(`
+data "aiven_project" "foo" {
+  project = "%s"
+}
+
+resource "aiven_kafka" "bar" {
+  project                 = data.aiven_project.foo.project
+  cloud_name              = "google-europe-west1"
+  plan                    = "business-4"
+  service_name            = "test-acc-sr-%s"
+  maintenance_window_dow  = "monday"
+  maintenance_window_time = "10:00:00"
+
+  kafka_user_config {
+    kafka_connect = true
+
+    kafka {
+      group_max_session_timeout_ms = 70000
+      log_retention_bytes          = 1000000000
+    }
+  }
+}
+
+resource "aiven_kafka_topic" "foo" {
+  project      = data.aiven_project.foo.project
+  service_name = aiven_kafka.bar.service_name
+  topic_name   = "test-acc-topic-%s"
+  partitions   = 3
+  replication  = 2
+}
+
+resource "aiven_opensearch" "dest" {
+  project                 = data.aiven_project.foo.project
+  cloud_name              = "google-europe-west1"
+  plan                    = "startup-4"
+  service_name            = "test-acc-sr2-%s"
+  maintenance_window_dow  = "monday"
+  maintenance_window_time = "10:00:00"
+}
+
+resource "aiven_kafka_connector" "foo" {
+  project        = data.aiven_project.foo.project
+  service_name   = aiven_kafka.bar.service_name
+  connector_name = "test-acc-con-%s"
+
+  config = {
+    "topics"          = aiven_kafka_topic.foo.topic_name
+    "connector.class" = "io.aiven.kafka.connect.opensearch.OpensearchSinkConnector"
+    "type.name"       = "es-connector"
+    "name"            = "test-acc-con-%s"
+    "connection.url"  = aiven_opensearch.dest.service_uri
+    "batch.size"      = "many"
+  }
+}
+
+data "aiven_kafka_connector" "connector" {
+  project        = aiven_kafka_connector.foo.project
+  service_name   = aiven_kafka_connector.foo.service_name
+  connector_name = aiven_kafka_connector.foo.connector_name
+
+  depends_on = [aiven_kafka_connector.foo]
+}`, acc.ProjectName(), name, name, name, name, name)
+}
+
 func testAccKafkaConnectorMonoSinkResource(name string) string {
 	return fmt.Sprintf(`
 data "aiven_project" "foo" {
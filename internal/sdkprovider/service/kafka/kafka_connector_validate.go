@@ -0,0 +1,128 @@
+package kafka
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"github.com/aiven/go-client-codegen/handler/service"
+	"github.com/hashicorp/go-cleanhttp"
+)
+
+const (
+	kafkaConnectComponent = "kafka_connect"
+	kafkaConnectAdminUser = "avnadmin"
+)
+
+// kafkaConnectHTTPClient calls the Kafka Connect REST API of the service, replaced in tests
+var kafkaConnectHTTPClient = &http.Client{
+	Transport: cleanhttp.DefaultPooledTransport(),
+	Timeout:   30 * time.Second,
+}
+
+var errNoKafkaConnectEndpoint = errors.New("the service has no public Kafka Connect endpoint")
+
+// kafkaConnectValidation is the response of the Kafka Connect validation endpoint
+type kafkaConnectValidation struct {
+	ErrorCount int `json:"error_count"`
+	Configs    []struct {
+		Value struct {
+			Name   string   `json:"name"`
+			Errors []string `json:"errors"`
+		} `json:"value"`
+	} `json:"configs"`
+}
+
+// validateKafkaConnectorConfigRemote calls the Kafka Connect validation endpoint of the service
+// and returns the errors per parameter.
+// The Kafka Connect REST API is served on the kafka_connect component of the service.
+func validateKafkaConnectorConfigRemote(ctx context.Context, client avngen.Client, project, serviceName, class string, config map[string]string) (map[string][]string, error) {
+	s, err := client.ServiceGet(ctx, project, serviceName, service.ServiceGetIncludeSecrets(true))
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint, err := kafkaConnectEndpoint(s)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := json.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint.Path = "/connector-plugins/" + url.PathEscape(class) + "/config/validate"
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, endpoint.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	rsp, err := kafkaConnectHTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer rsp.Body.Close()
+
+	b, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if rsp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("kafka connect validation endpoint returned %d: %s", rsp.StatusCode, b)
+	}
+
+	validation := new(kafkaConnectValidation)
+	if err := json.Unmarshal(b, validation); err != nil {
+		return nil, fmt.Errorf("cannot read Kafka connect validation: %w", err)
+	}
+
+	errs := make(map[string][]string)
+	for _, c := range validation.Configs {
+		if len(c.Value.Errors) > 0 {
+			errs[c.Value.Name] = append(errs[c.Value.Name], c.Value.Errors...)
+		}
+	}
+	return errs, nil
+}
+
+// kafkaConnectEndpoint returns the URL of the Kafka Connect REST API with the admin credentials.
+// Prefers the public route, the private and privatelink routes are often not reachable from where Terraform runs.
+func kafkaConnectEndpoint(s *service.ServiceGetOut) (*url.URL, error) {
+	var host string
+	var port int
+	for _, c := range s.Components {
+		if c.Component != kafkaConnectComponent || c.Usage != "primary" {
+			continue
+		}
+
+		if c.Route == service.RouteTypePublic || c.Route == service.RouteTypeDynamic && host == "" {
+			host, port = c.Host, c.Port
+		}
+	}
+
+	if host == "" {
+		return nil, errNoKafkaConnectEndpoint
+	}
+
+	for _, u := range s.Users {
+		if u.Username == kafkaConnectAdminUser {
+			return &url.URL{
+				Scheme: "https",
+				User:   url.UserPassword(u.Username, u.Password),
+				Host:   host + ":" + strconv.Itoa(port),
+			}, nil
+		}
+	}
+	return nil, fmt.Errorf("the service has no %s user", kafkaConnectAdminUser)
+}
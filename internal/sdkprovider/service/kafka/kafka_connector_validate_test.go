@@ -0,0 +1,92 @@
+package kafka
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"github.com/aiven/go-client-codegen/handler/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateKafkaConnectorConfigRemote(t *testing.T) {
+	ctx := context.Background()
+	config := map[string]string{
+		"name":            "foo",
+		"connector.class": "io.aiven.kafka.connect.opensearch.OpensearchSinkConnector",
+		"batch.size":      "many",
+	}
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, password, _ := r.BasicAuth()
+		assert.Equal(t, "avnadmin", user)
+		assert.Equal(t, "secret", password)
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/connector-plugins/io.aiven.kafka.connect.opensearch.OpensearchSinkConnector/config/validate", r.URL.Path)
+
+		var in map[string]string
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&in))
+		assert.Equal(t, config, in)
+
+		_, _ = w.Write([]byte(`{
+			"name": "io.aiven.kafka.connect.opensearch.OpensearchSinkConnector",
+			"error_count": 2,
+			"configs": [
+				{"value": {"name": "name", "value": "foo", "errors": []}},
+				{"value": {"name": "batch.size", "value": "many", "errors": ["Invalid value many for configuration batch.size: Not a number of type INT"]}},
+				{"value": {"name": "connection.url", "value": null, "errors": ["Missing required configuration \"connection.url\" which has no default value."]}}
+			]
+		}`))
+	}))
+	t.Cleanup(server.Close)
+
+	origClient := kafkaConnectHTTPClient
+	t.Cleanup(func() { kafkaConnectHTTPClient = origClient })
+	kafkaConnectHTTPClient = server.Client()
+
+	host, port, err := net.SplitHostPort(server.Listener.Addr().String())
+	require.NoError(t, err)
+
+	s := new(service.ServiceGetOut)
+	err = json.Unmarshal([]byte(fmt.Sprintf(`{
+		"components": [
+			{"component": "kafka_connect", "host": "private.example.com", "port": 1, "route": "private", "usage": "primary"},
+			{"component": "kafka_connect", "host": %q, "port": %s, "route": "dynamic", "usage": "primary"}
+		],
+		"users": [{"username": "avnadmin", "password": "secret"}]
+	}`, host, port)), s)
+	require.NoError(t, err)
+
+	client := avngen.NewMockClient(t)
+	client.EXPECT().ServiceGet(ctx, "foo", "bar", mock.Anything).Return(s, nil).Once()
+
+	errs, err := validateKafkaConnectorConfig(ctx, client, "foo", "bar", config["connector.class"], config)
+	require.NoError(t, err)
+	expected := map[string][]string{
+		"batch.size":     {"Invalid value many for configuration batch.size: Not a number of type INT"},
+		"connection.url": {"Missing required configuration \"connection.url\" which has no default value."},
+	}
+	assert.Equal(t, expected, errs)
+}
+
+func TestValidateKafkaConnectorConfigDefs(t *testing.T) {
+	defs := []kafkaConnectorConfigDef{
+		{Name: "connection.url", Type: "STRING", Required: true},
+		{Name: "batch.size", Type: "INT"},
+		{Name: "flush.timeout.ms", Type: "LONG", Required: true, DefaultValue: "10000"},
+	}
+
+	errs := validateKafkaConnectorConfigDefs(defs, map[string]string{"batch.size": "many"})
+	expected := map[string][]string{
+		"connection.url": {"the parameter is required"},
+		"batch.size":     {`expected INT, got "many"`},
+	}
+	assert.Equal(t, expected, errs)
+}
@@ -8,7 +8,6 @@ import (
 
 func DatasourceKafka() *schema.Resource {
 	return &schema.Resource{
-		ReadContext: schemautil.DatasourceServiceRead,
 		Description: "Gets information about an Aiven for Apache Kafka® service.",
 		Schema:      schemautil.ResourceSchemaAsDatasourceSchema(aivenKafkaSchema(), "project", "service_name"),
 	}
@@ -8,7 +8,6 @@ import (
 
 func DatasourceKafkaMirrormaker() *schema.Resource {
 	return &schema.Resource{
-		ReadContext: schemautil.DatasourceServiceRead,
 		Description: "Gets information about an Aiven for Apache Kafka® MirrorMaker 2 service.",
 		Schema:      schemautil.ResourceSchemaAsDatasourceSchema(aivenKafkaMirrormakerSchema(), "project", "service_name"),
 	}
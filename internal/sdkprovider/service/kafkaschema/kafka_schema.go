@@ -42,7 +42,7 @@ var aivenKafkaSchemaSchema = map[string]*schema.Schema{
 		StateFunc:        normalizeJSONOrProtobufString,
 		DiffSuppressFunc: diffSuppressJSONObjectOrProtobufString,
 		Description: "Kafka Schema configuration. Should be a valid Avro, JSON, or Protobuf schema," +
-			" depending on the schema type.",
+			" depending on the schema type. Checked for compatibility with the latest version of the subject when planning.",
 	},
 	"schema_type": {
 		Type:     schema.TypeString,
@@ -434,60 +434,96 @@ func kafkaSchemaHasAnyVersionReferences(
 	return false, nil
 }
 
+// resourceKafkaSchemaCustomizeDiff checks the new schema against the latest version of the subject,
+// so incompatible changes are rejected when planning
 func resourceKafkaSchemaCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, _ any) error {
 	client, err := common.GenClient()
 	if err != nil {
 		return err
 	}
 
-	schemaType := kafkaschemaregistry.SchemaType(d.Get("schema_type").(string))
-	schemaPayload := d.Get("schema").(string)
-
-	// 1. If the schema has references, the API is unable to validate schema compatibility.
-	// 2. Referenced types are resolved by Schema Registry; local parsing (avro.Parse) cannot validate them.
-	if expandKafkaSchemaReferences(d) != nil {
+	if d.Id() != "" && !d.HasChanges("subject_name", "schema", "references") {
 		return nil
 	}
 
-	if schemaType == kafkaschemaregistry.SchemaTypeAvro {
+	for _, k := range []string{"project", "service_name", "subject_name", "schema", "references"} {
+		if !d.NewValueKnown(k) {
+			return nil
+		}
+	}
+
+	schemaType := kafkaschemaregistry.SchemaType(d.Get("schema_type").(string))
+	schemaPayload := d.Get("schema").(string)
+	references := expandKafkaSchemaReferences(d)
+
+	// Referenced types are resolved by Schema Registry; local parsing (avro.Parse) cannot validate them.
+	if schemaType == kafkaschemaregistry.SchemaTypeAvro && references == nil {
 		_, err = avro.Parse(schemaPayload)
 		if err != nil {
 			return fmt.Errorf("schema validation error: %w", err)
 		}
 	}
 
-	// no previous version: allow the diff, nothing to check compatibility against
-	if _, ok := d.GetOk("version"); !ok {
+	project := d.Get("project").(string)
+	serviceName := d.Get("service_name").(string)
+	subjectName := d.Get("subject_name").(string)
+
+	// The schema registry is disabled, or the service or the subject doesn't exist yet
+	enabled, err := isSchemaRegistryEnabled(ctx, client, project, serviceName)
+	if avngen.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("unable to check schema registry status: %w", err)
+	}
+	if !enabled {
 		return nil
 	}
 
+	versions, err := client.ServiceSchemaRegistrySubjectVersionsGet(ctx, project, serviceName, subjectName)
+	if avngen.IsNotFound(err) || (err == nil && len(versions) == 0) {
+		// no previous version: allow the diff, nothing to check compatibility against
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("unable to get schema versions: %w", err)
+	}
+
+	// The schema is posted before compatibility_level is changed,
+	// so the registry checks it under the current effective level of the subject
+	level, err := client.ServiceSchemaRegistrySubjectConfigGet(
+		ctx,
+		project,
+		serviceName,
+		subjectName,
+		kafkaschemaregistry.ServiceSchemaRegistrySubjectConfigGetGlobalDefaultFallback(true),
+	)
+	if err != nil {
+		return fmt.Errorf("unable to get compatibility level: %w", err)
+	}
+
+	latest := slices.Max(versions)
 	r, err := client.ServiceSchemaRegistryCompatibility(
 		ctx,
-		d.Get("project").(string),
-		d.Get("service_name").(string),
-		d.Get("subject_name").(string),
-		d.Get("version").(int),
+		project,
+		serviceName,
+		subjectName,
+		latest,
 		&kafkaschemaregistry.ServiceSchemaRegistryCompatibilityIn{
+			References: references,
 			Schema:     schemaPayload,
 			SchemaType: schemaType,
 		},
 	)
 	if err != nil {
-		// if Schema Registry is disabled, skip compatibility check
-		project := d.Get("project").(string)
-		serviceName := d.Get("service_name").(string)
-		enabled, checkErr := isSchemaRegistryEnabled(ctx, client, project, serviceName)
-		if checkErr != nil {
-			return fmt.Errorf("unable to check schema registry status: %w", checkErr)
-		}
-		if !enabled {
-			return nil
-		}
 		return fmt.Errorf("unable to check schema validity: %w", err)
 	}
 
 	if !r.IsCompatible {
-		return fmt.Errorf("schema is not compatible with previous version: %s", strings.Join(r.Messages, ", "))
+		return fmt.Errorf(
+			"schema is not compatible with previous version %d under the %s compatibility level: %s",
+			latest, level, strings.Join(r.Messages, ", "),
+		)
 	}
 
 	return nil
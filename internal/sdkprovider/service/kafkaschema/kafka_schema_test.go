@@ -293,16 +293,11 @@ func TestAccAivenKafkaSchema(t *testing.T) {
 						resource.TestCheckResourceAttr(avroResourceName, "schema_type", "AVRO"),
 					),
 				},
-				// Schemas with references skip compatibility checks in CustomizeDiff, so an
-				// incompatible change still produces a non-empty plan instead of a plan error.
-				{
-					Config:             testAccKafkaSchemaAVROReferencesIncompatibleResource(projectName, serviceName, avroRefSubject, avroDepSubject),
-					PlanOnly:           true,
-					ExpectNonEmptyPlan: true,
-				},
+				// Schemas with references are checked with the references, so an incompatible change fails the plan
 				{
 					Config:      testAccKafkaSchemaAVROReferencesIncompatibleResource(projectName, serviceName, avroRefSubject, avroDepSubject),
-					ExpectError: regexp.MustCompile("Incompatible schema, compatibility_mode=BACKWARD. Incompatibilities: reader type: string not compatible with writer type: int"),
+					PlanOnly:    true,
+					ExpectError: regexp.MustCompile("schema is not compatible with previous version 2 under the BACKWARD compatibility level"),
 				},
 			},
 		})
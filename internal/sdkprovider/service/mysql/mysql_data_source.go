@@ -8,7 +8,6 @@ import (
 
 func DatasourceMySQL() *schema.Resource {
 	return &schema.Resource{
-		ReadContext: schemautil.DatasourceServiceRead,
 		Description: "Gets information about an Aiven for MySQL® service.",
 		Schema:      schemautil.ResourceSchemaAsDatasourceSchema(aivenMySQLSchema(), "project", "service_name"),
 	}
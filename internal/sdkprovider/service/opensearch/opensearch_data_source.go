@@ -8,7 +8,6 @@ import (
 
 func DatasourceOpenSearch() *schema.Resource {
 	return &schema.Resource{
-		ReadContext: schemautil.DatasourceServiceRead,
 		Description: "Gets information about an Aiven for OpenSearch® service.",
 		Schema:      schemautil.ResourceSchemaAsDatasourceSchema(opensearchSchema(), "project", "service_name"),
 	}
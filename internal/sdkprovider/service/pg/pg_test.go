@@ -515,6 +515,62 @@ data "aiven_pg" "common" {
 }`, acc.ProjectName(), name)
 }
 
+func TestAccAivenPG_powered(t *testing.T) {
+	resourceName := "aiven_pg.bar"
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestProtoV6ProviderFactories,
+		CheckDestroy:             acc.TestAccCheckAivenServiceResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPGResourcePowered(rName, "true"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "powered", "true"),
+					resource.TestCheckResourceAttr(resourceName, "state", "RUNNING"),
+				),
+			},
+			{
+				// The database keeps its state while the service is powered off
+				Config: testAccPGResourcePowered(rName, "false"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "powered", "false"),
+					resource.TestCheckResourceAttr(resourceName, "state", "POWEROFF"),
+					resource.TestCheckResourceAttr("aiven_pg_database.foo", "database_name", "test-acc-db"),
+				),
+			},
+			{
+				Config: testAccPGResourcePowered(rName, "true"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "powered", "true"),
+					resource.TestCheckResourceAttr(resourceName, "state", "RUNNING"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPGResourcePowered(name, powered string) string {
+	return fmt.Sprintf(`
+data "aiven_project" "foo" {
+  project = "%s"
+}
+
+resource "aiven_pg" "bar" {
+  project      = data.aiven_project.foo.project
+  cloud_name   = "google-europe-west1"
+  plan         = "startup-4"
+  service_name = "test-acc-sr-%s"
+  powered      = %s
+}
+
+resource "aiven_pg_database" "foo" {
+  project       = aiven_pg.bar.project
+  service_name  = aiven_pg.bar.service_name
+  database_name = "test-acc-db"
+}`, acc.ProjectName(), name, powered)
+}
+
 func testAccPGResourcePlanChange(name, plan string) string {
 	return fmt.Sprintf(`
 data "aiven_project" "foo" {
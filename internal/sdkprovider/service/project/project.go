@@ -89,9 +89,10 @@ var aivenProjectSchema = map[string]*schema.Schema{
 		DiffSuppressFunc: schemautil.EmptyObjectDiffSuppressFunc,
 	},
 	"tag": {
-		Description: "Tags are key-value pairs that allow you to categorize projects.",
+		Description: "Tags are key-value pairs that allow you to categorize projects. The provider `default_tags` are added to them.",
 		Type:        schema.TypeSet,
 		Optional:    true,
+		Computed:    true, // has the provider default_tags, see schemautil.CustomizeDiffDefaultTags
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"key": {
@@ -143,9 +144,9 @@ func ResourceProject() *schema.Resource {
 		Timeouts: schemautil.DefaultResourceTimeouts(),
 
 		Schema: aivenProjectSchema,
-		CustomizeDiff: customdiff.IfValueChange("tag",
-			schemautil.ShouldNotBeEmpty,
+		CustomizeDiff: customdiff.Sequence(
 			schemautil.CustomizeDiffCheckUniqueTag,
+			schemautil.CustomizeDiffDefaultTags,
 		),
 	}
 }
@@ -0,0 +1,25 @@
+package service
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
+)
+
+func ResourceService() *schema.Resource {
+	return &schema.Resource{
+		Description: "Creates and manages an Aiven service of any service type. " +
+			"The service configuration is set with the JSON-encoded `user_config`. " +
+			"To manage a service of the `aiven_<service_type>` resource with this resource, use the `moved` block.",
+		CreateContext: schemautil.ResourceServiceCreate,
+		ReadContext:   schemautil.ResourceServiceRead,
+		UpdateContext: schemautil.ResourceServiceUpdate,
+		DeleteContext: schemautil.ResourceServiceDelete,
+		CustomizeDiff: schemautil.CustomizeDiffServiceAnyType(),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: schemautil.DefaultResourceTimeouts(),
+		Schema:   schemautil.ServiceGenericSchema(),
+	}
+}
@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/aiven/terraform-provider-aiven/internal/common"
+	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
+)
+
+// waitForServiceMaintenance and waitForServiceUpdate wait for the maintenance, replaced in tests
+var (
+	waitForServiceMaintenance = schemautil.WaitForServiceMaintenance
+	waitForServiceUpdate      = schemautil.WaitForServiceUpdate
+)
+
+func serviceMaintenanceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"project":      schemautil.CommonSchemaProjectReference,
+		"service_name": schemautil.CommonSchemaServiceNameReference,
+		"triggers": {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Arbitrary values that start the maintenance again when changed, for instance, the version of the environment rollout.",
+		},
+		"pending_maintenance_updates": schemautil.ServiceCommonSchema()["pending_maintenance_updates"],
+	}
+}
+
+func ResourceServiceMaintenance() *schema.Resource {
+	return &schema.Resource{
+		Description: "Applies the pending maintenance updates of a service before the " +
+			"[maintenance window](https://aiven.io/docs/platform/concepts/maintenance-window) " +
+			"and waits until the service is `RUNNING`. The maintenance starts when the resource is created " +
+			"and when `triggers` change. Use `depends_on` to apply the updates to the services in order, " +
+			"for instance, to the development services first. Deleting the resource doesn't change the service.",
+		CreateContext: common.WithGenClient(resourceServiceMaintenanceCreate),
+		ReadContext:   common.WithGenClient(resourceServiceMaintenanceRead),
+		UpdateContext: common.WithGenClient(resourceServiceMaintenanceUpdate),
+		DeleteContext: common.WithGenClient(resourceServiceMaintenanceDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: schemautil.DefaultResourceTimeouts(),
+		Schema:   serviceMaintenanceSchema(),
+	}
+}
+
+func resourceServiceMaintenanceCreate(ctx context.Context, d *schema.ResourceData, client avngen.Client) error {
+	projectName := d.Get("project").(string)
+	serviceName := d.Get("service_name").(string)
+	if err := startServiceMaintenance(ctx, d, client); err != nil {
+		return err
+	}
+
+	d.SetId(schemautil.BuildResourceID(projectName, serviceName))
+	return resourceServiceMaintenanceRead(ctx, d, client)
+}
+
+func resourceServiceMaintenanceUpdate(ctx context.Context, d *schema.ResourceData, client avngen.Client) error {
+	if err := startServiceMaintenance(ctx, d, client); err != nil {
+		return err
+	}
+	return resourceServiceMaintenanceRead(ctx, d, client)
+}
+
+// startServiceMaintenance applies the pending updates and waits until the service is RUNNING and ready
+func startServiceMaintenance(ctx context.Context, d *schema.ResourceData, client avngen.Client) error {
+	projectName := d.Get("project").(string)
+	serviceName := d.Get("service_name").(string)
+	s, err := client.ServiceGet(ctx, projectName, serviceName)
+	if err != nil {
+		return err
+	}
+
+	updates, err := schemautil.FlattenServiceMaintenanceUpdates(s)
+	if err != nil {
+		return err
+	}
+
+	if len(updates) == 0 {
+		// Nothing to apply
+		return nil
+	}
+
+	err = client.ServiceMaintenanceStart(ctx, projectName, serviceName)
+	if err != nil {
+		return fmt.Errorf("error starting the maintenance: %w", err)
+	}
+
+	_, err = waitForServiceMaintenance(ctx, d, client, updates)
+	if err != nil {
+		return fmt.Errorf("error waiting for the maintenance: %w", err)
+	}
+
+	// The readiness checks of the service, for instance, the backups
+	_, err = waitForServiceUpdate(ctx, d, client)
+	if err != nil {
+		return fmt.Errorf("error waiting for the maintenance: %w", err)
+	}
+	return nil
+}
+
+func resourceServiceMaintenanceRead(ctx context.Context, d *schema.ResourceData, client avngen.Client) error {
+	projectName, serviceName, err := schemautil.SplitResourceID2(d.Id())
+	if err != nil {
+		return err
+	}
+
+	s, err := client.ServiceGet(ctx, projectName, serviceName)
+	if err != nil {
+		return schemautil.ResourceReadHandleNotFound(err, d)
+	}
+
+	updates, err := schemautil.FlattenServiceMaintenanceUpdates(s)
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("project", projectName); err != nil {
+		return err
+	}
+	if err := d.Set("service_name", serviceName); err != nil {
+		return err
+	}
+	return d.Set("pending_maintenance_updates", updates)
+}
+
+func resourceServiceMaintenanceDelete(_ context.Context, _ *schema.ResourceData, _ avngen.Client) error {
+	// The applied updates can't be reverted
+	return nil
+}
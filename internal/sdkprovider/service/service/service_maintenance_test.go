@@ -0,0 +1,138 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	avngen "github.com/aiven/go-client-codegen"
+	"github.com/aiven/go-client-codegen/handler/service"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
+)
+
+const (
+	testProject     = "test-project"
+	testServiceName = "test-service"
+)
+
+// testServiceGetOut returns the service with the pending maintenance updates
+func testServiceGetOut(t *testing.T, updates ...string) *service.ServiceGetOut {
+	list := make([]map[string]any, 0, len(updates))
+	for _, u := range updates {
+		list = append(list, map[string]any{"description": u, "deadline": "2026-11-01T00:00:00Z", "impact": "restart"})
+	}
+
+	b, err := json.Marshal(map[string]any{
+		"service_name": testServiceName,
+		"state":        service.ServiceStateTypeRunning,
+		"maintenance":  map[string]any{"dow": "monday", "time": "10:00:00", "updates": list},
+	})
+	require.NoError(t, err)
+
+	out := new(service.ServiceGetOut)
+	require.NoError(t, json.Unmarshal(b, out))
+	return out
+}
+
+// mockMaintenanceWait replaces the maintenance waiters and returns the number of the waits
+func mockMaintenanceWait(t *testing.T) *int {
+	waits := new(int)
+	waitForServiceMaintenance = func(_ context.Context, _ schemautil.ResourceData, _ avngen.Client, updates []map[string]any) (*service.ServiceGetOut, error) {
+		assert.NotEmpty(t, updates)
+		*waits++
+		return nil, nil
+	}
+	waitForServiceUpdate = func(context.Context, schemautil.ResourceData, avngen.Client) (*service.ServiceGetOut, error) {
+		return nil, nil
+	}
+	t.Cleanup(func() {
+		waitForServiceMaintenance = schemautil.WaitForServiceMaintenance
+		waitForServiceUpdate = schemautil.WaitForServiceUpdate
+	})
+	return waits
+}
+
+func TestResourceServiceMaintenanceCreate(t *testing.T) {
+	ctx := context.Background()
+	r := ResourceServiceMaintenance()
+
+	t.Run("no pending updates", func(t *testing.T) {
+		waits := mockMaintenanceWait(t)
+		client := avngen.NewMockClient(t)
+
+		// Reads the service to start and after, no ServiceMaintenanceStart call
+		client.EXPECT().ServiceGet(ctx, testProject, testServiceName).Return(testServiceGetOut(t), nil).Twice()
+
+		d := schema.TestResourceDataRaw(t, r.Schema, map[string]any{
+			"project":      testProject,
+			"service_name": testServiceName,
+		})
+		require.NoError(t, resourceServiceMaintenanceCreate(ctx, d, client))
+		assert.Equal(t, testProject+"/"+testServiceName, d.Id())
+		assert.Empty(t, d.Get("pending_maintenance_updates"))
+		assert.Equal(t, 0, *waits)
+	})
+
+	t.Run("pending updates", func(t *testing.T) {
+		waits := mockMaintenanceWait(t)
+		client := avngen.NewMockClient(t)
+		client.EXPECT().ServiceGet(ctx, testProject, testServiceName).Return(testServiceGetOut(t, "Upgrade to PG 16.4"), nil).Once()
+		client.EXPECT().ServiceMaintenanceStart(ctx, testProject, testServiceName).Return(nil).Once()
+		client.EXPECT().ServiceGet(ctx, testProject, testServiceName).Return(testServiceGetOut(t), nil).Once()
+
+		d := schema.TestResourceDataRaw(t, r.Schema, map[string]any{
+			"project":      testProject,
+			"service_name": testServiceName,
+		})
+		require.NoError(t, resourceServiceMaintenanceCreate(ctx, d, client))
+		assert.Equal(t, testProject+"/"+testServiceName, d.Id())
+		assert.Equal(t, 1, *waits)
+	})
+}
+
+func TestResourceServiceMaintenanceUpdate(t *testing.T) {
+	ctx := context.Background()
+	r := ResourceServiceMaintenance()
+	id := testProject + "/" + testServiceName
+	state := &terraform.InstanceState{
+		ID: id,
+		Attributes: map[string]string{
+			"id":               id,
+			"project":          testProject,
+			"service_name":     testServiceName,
+			"triggers.%":       "1",
+			"triggers.rollout": "1",
+		},
+	}
+
+	// A triggers change updates the resource, it doesn't replace it
+	config := terraform.NewResourceConfigRaw(map[string]any{
+		"project":      testProject,
+		"service_name": testServiceName,
+		"triggers":     map[string]any{"rollout": "2"},
+	})
+	diff, err := r.Diff(ctx, state, config, nil)
+	require.NoError(t, err)
+	require.NotNil(t, diff)
+	assert.False(t, diff.RequiresNew())
+
+	d, err := schema.InternalMap(r.Schema).Data(state, diff)
+	require.NoError(t, err)
+	require.True(t, d.HasChange("triggers"))
+
+	// The maintenance starts again
+	waits := mockMaintenanceWait(t)
+	client := avngen.NewMockClient(t)
+	client.EXPECT().ServiceGet(ctx, testProject, testServiceName).Return(testServiceGetOut(t, "Upgrade to PG 16.5"), nil).Once()
+	client.EXPECT().ServiceMaintenanceStart(ctx, testProject, testServiceName).Return(nil).Once()
+	client.EXPECT().ServiceGet(ctx, testProject, testServiceName).Return(testServiceGetOut(t), nil).Once()
+
+	require.NoError(t, resourceServiceMaintenanceUpdate(ctx, d, client))
+	assert.Equal(t, "2", d.Get("triggers.rollout"))
+	assert.Equal(t, 1, *waits)
+}
@@ -0,0 +1,153 @@
+package service_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+
+	acc "github.com/aiven/terraform-provider-aiven/internal/acctest"
+)
+
+func TestAccAivenService_basic(t *testing.T) {
+	resourceName := "aiven_service.bar"
+	serviceName := acc.RandName("service")
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestProtoV6ProviderFactories,
+		CheckDestroy:             acc.TestAccCheckAivenServiceResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceResource(serviceName, 100),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "project", acc.ProjectName()),
+					resource.TestCheckResourceAttr(resourceName, "service_name", serviceName),
+					resource.TestCheckResourceAttr(resourceName, "service_type", "pg"),
+					resource.TestCheckResourceAttr(resourceName, "state", "RUNNING"),
+					resource.TestCheckResourceAttrSet(resourceName, "service_uri"),
+					resource.TestCheckResourceAttrWith(resourceName, "user_config", expectJSON(
+						`{"pg_version": "16", "pg": {"max_connections": 100}}`,
+					)),
+				),
+			},
+			{
+				// Changes an option of the user config
+				Config: testAccServiceResource(serviceName, 200),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckResourceAttrWith(resourceName, "user_config", expectJSON(
+					`{"pg_version": "16", "pg": {"max_connections": 200}}`,
+				)),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"user_config", "service_password"},
+			},
+		},
+	})
+}
+
+func TestAccAivenService_moved(t *testing.T) {
+	serviceName := acc.RandName("service")
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestProtoV6ProviderFactories,
+		CheckDestroy:             acc.TestAccCheckAivenServiceResourceDestroy,
+		// Moving the resources of different types requires Terraform 1.8
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "aiven_pg" "bar" {
+  project                 = %[1]q
+  cloud_name              = "google-europe-west1"
+  plan                    = "startup-4"
+  service_name            = %[2]q
+  maintenance_window_dow  = "monday"
+  maintenance_window_time = "10:00:00"
+
+  pg_user_config {
+    pg_version = "16"
+
+    pg {
+      max_connections = 100
+    }
+  }
+}
+`, acc.ProjectName(), serviceName),
+				Check: resource.TestCheckResourceAttr("aiven_pg.bar", "state", "RUNNING"),
+			},
+			{
+				// The typed user config becomes the JSON-encoded user_config, nothing to change
+				Config: testAccServiceResource(serviceName, 100) + `
+moved {
+  from = aiven_pg.bar
+  to   = aiven_service.bar
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aiven_service.bar", "service_type", "pg"),
+					resource.TestCheckResourceAttrWith("aiven_service.bar", "user_config", expectJSON(
+						`{"pg_version": "16", "pg": {"max_connections": 100}}`,
+					)),
+				),
+			},
+		},
+	})
+}
+
+func testAccServiceResource(serviceName string, maxConnections int) string {
+	return fmt.Sprintf(`
+resource "aiven_service" "bar" {
+  project                 = %[1]q
+  cloud_name              = "google-europe-west1"
+  plan                    = "startup-4"
+  service_name            = %[2]q
+  service_type            = "pg"
+  maintenance_window_dow  = "monday"
+  maintenance_window_time = "10:00:00"
+
+  user_config = jsonencode({
+    pg_version = "16"
+    pg = {
+      max_connections = %[3]d
+    }
+  })
+}
+`, acc.ProjectName(), serviceName, maxConnections)
+}
+
+// expectJSON compares the JSON-encoded attribute with the expected JSON
+func expectJSON(expected string) resource.CheckResourceAttrWithFunc {
+	return func(value string) error {
+		var a, b any
+		if err := json.Unmarshal([]byte(expected), &a); err != nil {
+			return err
+		}
+		if err := json.Unmarshal([]byte(value), &b); err != nil {
+			return fmt.Errorf("invalid JSON %q: %w", value, err)
+		}
+
+		aj, _ := json.Marshal(a)
+		bj, _ := json.Marshal(b)
+		if string(aj) != string(bj) {
+			return fmt.Errorf("expected %s, got %s", aj, bj)
+		}
+		return nil
+	}
+}
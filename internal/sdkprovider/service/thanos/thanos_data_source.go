@@ -8,7 +8,6 @@ import (
 
 func DatasourceThanos() *schema.Resource {
 	return &schema.Resource{
-		ReadContext: schemautil.DatasourceServiceRead,
 		Description: "Gets information about an Aiven for Thanos® service.",
 		Schema:      schemautil.ResourceSchemaAsDatasourceSchema(thanosSchema(), "project", "service_name"),
 	}
@@ -8,7 +8,6 @@ import (
 
 func DatasourceValkey() *schema.Resource {
 	return &schema.Resource{
-		ReadContext: schemautil.DatasourceServiceRead,
 		Description: "Gets information about an Aiven for Valkey service.",
 		Schema:      schemautil.ResourceSchemaAsDatasourceSchema(valkeySchema(), "project", "service_name"),
 	}
@@ -0,0 +1,217 @@
+// Package apischema validates user configs against the API schemas,
+// which the typed user configs of the resources are generated from.
+package apischema
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+
+	"github.com/aiven/go-api-schemas/pkg/dist"
+	"gopkg.in/yaml.v3"
+)
+
+// object is a JSON schema object of the API schemas
+type object struct {
+	Type       any                `yaml:"type"` // a type or a list of types
+	Properties map[string]*object `yaml:"properties"`
+	Items      *object            `yaml:"items"`
+	OneOf      []*object          `yaml:"one_of"`
+	Enum       []*objectEnum      `yaml:"enum"`
+	MinItems   *int               `yaml:"min_items"`
+	MaxItems   *int               `yaml:"max_items"`
+	MinLength  *int               `yaml:"min_length"`
+	MaxLength  *int               `yaml:"max_length"`
+}
+
+type objectEnum struct {
+	Value string `yaml:"value"`
+}
+
+var serviceTypes = sync.OnceValues(func() (map[string]*object, error) {
+	var m map[string]*object
+	err := yaml.Unmarshal(dist.ServiceTypes, &m)
+	return m, err
+})
+
+// ServiceTypes returns the service types that have a user config schema
+func ServiceTypes() []string {
+	m, err := serviceTypes()
+	if err != nil {
+		return nil
+	}
+	return slices.Sorted(maps.Keys(m))
+}
+
+// ValidateServiceUserConfig validates the user config of the service type.
+// Returns all errors joined.
+func ValidateServiceUserConfig(serviceType string, config map[string]any) error {
+	m, err := serviceTypes()
+	if err != nil {
+		return fmt.Errorf("unable to read the user config schemas: %w", err)
+	}
+
+	o, ok := m[serviceType]
+	if !ok {
+		return fmt.Errorf("unknown service type %q", serviceType)
+	}
+	return errors.Join(validateObject(o, "", config)...)
+}
+
+// types returns the types of the object, "null" if the value can be null
+func (o *object) types() []string {
+	switch v := o.Type.(type) {
+	case string:
+		return []string{v}
+	case []any:
+		result := make([]string, 0, len(v))
+		for _, t := range v {
+			result = append(result, fmt.Sprint(t))
+		}
+		return result
+	}
+	return nil
+}
+
+// validate returns the errors of the value against the schema object, the path is the prefix of the errors
+func validate(o *object, path string, value any) []error {
+	if len(o.OneOf) > 0 {
+		for _, v := range o.OneOf {
+			if len(validate(v, path, value)) == 0 {
+				return nil
+			}
+		}
+		return []error{fmt.Errorf("%s: the value doesn't match any of the allowed types", path)}
+	}
+
+	types := o.types()
+	if len(types) == 0 {
+		// Any type
+		return nil
+	}
+
+	if value == nil {
+		if slices.Contains(types, "null") {
+			return nil
+		}
+		return []error{fmt.Errorf("%s: can't be null", path)}
+	}
+
+	valueType := typeOf(value)
+	if !slices.Contains(types, valueType) && !(valueType == "integer" && slices.Contains(types, "number")) {
+		return []error{fmt.Errorf("%s: expected %s, got %s", path, typesString(types), valueType)}
+	}
+
+	if len(o.Enum) > 0 {
+		s := fmt.Sprint(value)
+		if !slices.ContainsFunc(o.Enum, func(e *objectEnum) bool { return e.Value == s }) {
+			values := make([]string, 0, len(o.Enum))
+			for _, e := range o.Enum {
+				values = append(values, e.Value)
+			}
+			return []error{fmt.Errorf("%s: expected one of %q, got %q", path, values, s)}
+		}
+	}
+
+	switch v := value.(type) {
+	case map[string]any:
+		return validateObject(o, path, v)
+	case []any:
+		return validateArray(o, path, v)
+	case string:
+		if o.MinLength != nil && len(v) < *o.MinLength {
+			return []error{fmt.Errorf("%s: expected at least %d characters", path, *o.MinLength)}
+		}
+		if o.MaxLength != nil && len(v) > *o.MaxLength {
+			return []error{fmt.Errorf("%s: expected at most %d characters", path, *o.MaxLength)}
+		}
+	}
+	return nil
+}
+
+// validateObject rejects the unknown properties of the object and validates the known ones
+func validateObject(o *object, path string, m map[string]any) []error {
+	// An object without properties is a free-form map
+	if len(o.Properties) == 0 {
+		return nil
+	}
+
+	var errs []error
+	for _, k := range slices.Sorted(maps.Keys(m)) {
+		p := joinPath(path, k)
+		prop, ok := o.Properties[k]
+		if !ok {
+			errs = append(errs, fmt.Errorf("%s: unknown field", p))
+			continue
+		}
+		errs = append(errs, validate(prop, p, m[k])...)
+	}
+	return errs
+}
+
+// validateArray validates the number of items and each item of the array
+func validateArray(o *object, path string, list []any) []error {
+	if o.MinItems != nil && len(list) < *o.MinItems {
+		return []error{fmt.Errorf("%s: expected at least %d items", path, *o.MinItems)}
+	}
+	if o.MaxItems != nil && len(list) > *o.MaxItems {
+		return []error{fmt.Errorf("%s: expected at most %d items", path, *o.MaxItems)}
+	}
+
+	if o.Items == nil {
+		return nil
+	}
+
+	var errs []error
+	for i, v := range list {
+		errs = append(errs, validate(o.Items, fmt.Sprintf("%s[%d]", path, i), v)...)
+	}
+	return errs
+}
+
+// typeOf returns the JSON schema type of the value decoded with json.Decoder.UseNumber
+func typeOf(value any) string {
+	switch v := value.(type) {
+	case map[string]any:
+		return "object"
+	case []any:
+		return "array"
+	case string:
+		return "string"
+	case bool:
+		return "boolean"
+	case json.Number:
+		if _, err := v.Int64(); err == nil {
+			return "integer"
+		}
+		return "number"
+	case float64:
+		if v == float64(int64(v)) {
+			return "integer"
+		}
+		return "number"
+	}
+	return fmt.Sprintf("%T", value)
+}
+
+// typesString returns the types for the error messages, without "null"
+func typesString(types []string) string {
+	types = slices.DeleteFunc(slices.Clone(types), func(t string) bool {
+		return t == "null"
+	})
+	if len(types) == 1 {
+		return types[0]
+	}
+	return fmt.Sprintf("one of %q", types)
+}
+
+// joinPath returns the path of the object property, for instance, "pg.max_connections"
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
@@ -0,0 +1,87 @@
+package apischema
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServiceTypes(t *testing.T) {
+	types := ServiceTypes()
+	assert.Contains(t, types, "pg")
+	assert.Contains(t, types, "kafka")
+}
+
+func TestValidateServiceUserConfig(t *testing.T) {
+	cases := []struct {
+		name        string
+		serviceType string
+		config      string
+		errors      []string
+	}{
+		{
+			name:        "valid",
+			serviceType: "pg",
+			config:      `{"pg_version": "16", "pg": {"max_connections": 100}, "ip_filter": ["10.0.0.0/8", {"network": "10.0.0.0/16"}]}`,
+		},
+		{
+			name:        "empty",
+			serviceType: "kafka",
+			config:      `{}`,
+		},
+		{
+			name:        "unknown service type",
+			serviceType: "foo",
+			config:      `{}`,
+			errors:      []string{`unknown service type "foo"`},
+		},
+		{
+			name:        "unknown fields",
+			serviceType: "pg",
+			config:      `{"foo": 1, "pg": {"bar": true}}`,
+			errors:      []string{"foo: unknown field", "pg.bar: unknown field"},
+		},
+		{
+			name:        "invalid types",
+			serviceType: "pg",
+			config:      `{"pg": {"max_connections": "100"}, "static_ips": 1, "pglookout": {"max_failover_replication_time_lag": 1.5}}`,
+			errors: []string{
+				"pg.max_connections: expected integer, got string",
+				"pglookout.max_failover_replication_time_lag: expected integer, got number",
+				"static_ips: expected boolean, got integer",
+			},
+		},
+		{
+			name:        "invalid enum",
+			serviceType: "pg",
+			config:      `{"pg_version": "1"}`,
+			errors:      []string{`pg_version: expected one of`},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := json.NewDecoder(strings.NewReader(tc.config))
+			d.UseNumber()
+
+			var config map[string]any
+			require.NoError(t, d.Decode(&config))
+
+			err := ValidateServiceUserConfig(tc.serviceType, config)
+			if len(tc.errors) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+			lines := strings.Split(err.Error(), "\n")
+			require.Len(t, lines, len(tc.errors))
+			for i, e := range tc.errors {
+				assert.Contains(t, lines[i], e)
+			}
+		})
+	}
+}
@@ -87,6 +87,7 @@ func SetUserConfig(kind userConfigType, name string, s map[string]*schema.Schema
 	s[userConfigKey(kind, name)] = userConfig
 }
 
+// Expand converts the user config of the resource config into a DTO map for the API request
 func Expand(kind userConfigType, name string, d resourceData) (map[string]any, error) {
 	m, err := expand(kind, name, d)
 	if err != nil {
@@ -346,6 +347,94 @@ func expandList(s *stateCompose) (any, error) {
 	return items, nil
 }
 
+// ExpandState converts the user config of a resource state into a DTO map.
+// Unlike Expand, it doesn't know the config, so it takes the values that are set in the state,
+// for instance, to move the state into another resource type.
+func ExpandState(kind userConfigType, name string, state map[string]any) (map[string]any, error) {
+	s := getUserConfig(kind, name)
+	if s == nil {
+		// does not have a user config for given kind and name
+		return nil, nil
+	}
+
+	dto := make(map[string]any)
+	list, _ := state[userConfigKey(kind, name)].([]any)
+	if len(list) == 0 || list[0] == nil {
+		return dto, nil
+	}
+
+	m, ok := list[0].(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("%s user config for %q expected to be map, but got %T", kind, name, list[0])
+	}
+
+	dto = expandStateObj(s.Elem.(*schema.Resource).Schema, m)
+
+	// Renames ip_filter_object/string to ip_filter
+	renameAliasesToDto(kind, name, dto)
+	return dto, nil
+}
+
+// expandStateObj converts the object of the state, skips the fields that are not in the schema
+func expandStateObj(s map[string]*schema.Schema, m map[string]any) map[string]any {
+	dto := make(map[string]any)
+	for k, v := range m {
+		subSchema, ok := s[k]
+		if !ok || v == nil {
+			continue
+		}
+
+		if value := expandStateAttr(subSchema, v); value != nil {
+			dto[k] = value
+		}
+	}
+	return dto
+}
+
+// expandStateAttr returns nil for empty lists and maps, they are not set in the state
+func expandStateAttr(s *schema.Schema, v any) any {
+	switch s.Type {
+	case schema.TypeSet, schema.TypeList:
+		// See below
+	case schema.TypeMap:
+		if m, ok := v.(map[string]any); ok && len(m) == 0 {
+			return nil
+		}
+		return v
+	default:
+		return v
+	}
+
+	list, _ := v.([]any)
+	resource, isObjList := s.Elem.(*schema.Resource)
+	items := make([]any, 0, len(list))
+	for _, item := range list {
+		if !isObjList {
+			if item != nil {
+				items = append(items, item)
+			}
+			continue
+		}
+
+		if m, ok := item.(map[string]any); ok {
+			// Avoids sending empty objects
+			if obj := expandStateObj(resource.Schema, m); len(obj) > 0 {
+				items = append(items, obj)
+			}
+		}
+	}
+
+	switch {
+	case len(items) == 0:
+		return nil
+	case isObjList && s.MaxItems == 1:
+		// A plain object (in TF a list with one object is an object)
+		return items[0]
+	}
+	return items
+}
+
+// Flatten sets the user config of the API response (DTO) into the resource state
 func Flatten(kind userConfigType, name string, d resourceData, dto map[string]any) error {
 	err := flatten(kind, name, d, dto)
 	if err != nil {
@@ -68,3 +68,39 @@ func TestFlattenSafe(t *testing.T) {
 		})
 	}
 }
+
+func TestExpandState(t *testing.T) {
+	// The JSON state of aiven_pg
+	const state = `{
+		"pg_user_config": [{
+			"admin_password": "secret",
+			"ip_filter_object": [{"network": "10.0.0.0/8", "description": null}],
+			"ip_filter_string": [],
+			"pg": [{"max_connections": 100, "work_mem": null}],
+			"pgbouncer": [],
+			"pglookout": [{"max_failover_replication_time_lag": null}],
+			"pg_version": "16",
+			"static_ips": false
+		}]
+	}`
+
+	var m map[string]any
+	require.NoError(t, json.Unmarshal([]byte(state), &m))
+
+	dto, err := ExpandState(ServiceUserConfig, "pg", m)
+	require.NoError(t, err)
+
+	expected := map[string]any{
+		"admin_password": "secret",
+		"ip_filter":      []any{map[string]any{"network": "10.0.0.0/8"}},
+		"pg":             map[string]any{"max_connections": float64(100)},
+		"pg_version":     "16",
+		"static_ips":     false,
+	}
+	assert.Equal(t, expected, dto)
+
+	// No user config
+	dto, err = ExpandState(ServiceUserConfig, "pg", map[string]any{"pg_user_config": []any{}})
+	require.NoError(t, err)
+	assert.Empty(t, dto)
+}
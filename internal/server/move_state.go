@@ -0,0 +1,61 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+
+	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
+)
+
+// MoveResourceState supports the moved blocks from the typed service resources to aiven_service, the SDK doesn't.
+// Converts the state of a typed service resource into the aiven_service state,
+// then the SDK upgrades it, which removes the fields that aiven_service doesn't have.
+func (s *planWarningsServer) MoveResourceState(ctx context.Context, req *tfprotov6.MoveResourceStateRequest) (*tfprotov6.MoveResourceStateResponse, error) {
+	if req.TargetTypeName != "aiven_service" {
+		return s.ProviderServer.MoveResourceState(ctx, req)
+	}
+
+	state, err := moveServiceState(req)
+	if err != nil {
+		return &tfprotov6.MoveResourceStateResponse{
+			Diagnostics: []*tfprotov6.Diagnostic{{
+				Severity: tfprotov6.DiagnosticSeverityError,
+				Summary:  "Unable to move the resource state",
+				Detail:   err.Error(),
+			}},
+		}, nil
+	}
+
+	resp, err := s.ProviderServer.UpgradeResourceState(ctx, &tfprotov6.UpgradeResourceStateRequest{
+		TypeName: req.TargetTypeName,
+		RawState: &tfprotov6.RawState{JSON: state},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &tfprotov6.MoveResourceStateResponse{
+		TargetState: resp.UpgradedState,
+		Diagnostics: resp.Diagnostics,
+	}, nil
+}
+
+func moveServiceState(req *tfprotov6.MoveResourceStateRequest) ([]byte, error) {
+	if req.SourceState == nil || req.SourceState.JSON == nil {
+		return nil, fmt.Errorf("the state of %s is empty", req.SourceTypeName)
+	}
+
+	var state map[string]any
+	if err := json.Unmarshal(req.SourceState.JSON, &state); err != nil {
+		return nil, err
+	}
+
+	state, err := schemautil.MoveServiceState(req.SourceTypeName, state)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(state)
+}
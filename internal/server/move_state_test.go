@@ -0,0 +1,98 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aiven/terraform-provider-aiven/internal/sdkprovider/service/service"
+)
+
+func TestMoveResourceState(t *testing.T) {
+	ctx := context.Background()
+	p := &schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{
+			"aiven_service": service.ResourceService(),
+		},
+	}
+	sdkServer, err := tf5to6server.UpgradeServer(ctx, p.GRPCProvider)
+	require.NoError(t, err)
+
+	s := &planWarningsServer{ProviderServer: sdkServer}
+	schemas, err := s.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+	stateType := schemas.ResourceSchemas["aiven_service"].ValueType()
+
+	t.Run("moves a service resource", func(t *testing.T) {
+		resp, err := s.MoveResourceState(ctx, &tfprotov6.MoveResourceStateRequest{
+			SourceTypeName: "aiven_pg",
+			TargetTypeName: "aiven_service",
+			SourceState: &tfprotov6.RawState{JSON: []byte(`{
+				"id": "foo/bar",
+				"project": "foo",
+				"service_name": "bar",
+				"service_type": "pg",
+				"plan": "startup-4",
+				"pg_user_config": [{"pg_version": "16", "pg": [{"max_connections": 100}]}],
+				"pg": [{"uri": "postgres://"}]
+			}`)},
+		})
+		require.NoError(t, err)
+		require.Empty(t, resp.Diagnostics)
+
+		v, err := resp.TargetState.Unmarshal(stateType)
+		require.NoError(t, err)
+
+		var state map[string]tftypes.Value
+		require.NoError(t, v.As(&state))
+
+		// The fields that aiven_service doesn't have are removed
+		assert.NotContains(t, state, "pg_user_config")
+		assert.NotContains(t, state, "pg")
+
+		var id, userConfig string
+		require.NoError(t, state["id"].As(&id))
+		require.NoError(t, state["user_config"].As(&userConfig))
+		assert.Equal(t, "foo/bar", id)
+		assert.JSONEq(t, `{"pg_version": "16", "pg": {"max_connections": 100}}`, userConfig)
+	})
+
+	t.Run("not a service resource", func(t *testing.T) {
+		resp, err := s.MoveResourceState(ctx, &tfprotov6.MoveResourceStateRequest{
+			SourceTypeName: "aiven_pg_user",
+			TargetTypeName: "aiven_service",
+			SourceState:    &tfprotov6.RawState{JSON: []byte(`{"id": "foo/bar/baz", "project": "foo"}`)},
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Diagnostics, 1)
+		assert.Equal(t, "Unable to move the resource state", resp.Diagnostics[0].Summary)
+		assert.Contains(t, resp.Diagnostics[0].Detail, "can't move aiven_pg_user to aiven_service")
+	})
+
+	t.Run("another service type", func(t *testing.T) {
+		resp, err := s.MoveResourceState(ctx, &tfprotov6.MoveResourceStateRequest{
+			SourceTypeName: "aiven_kafka",
+			TargetTypeName: "aiven_service",
+			SourceState:    &tfprotov6.RawState{JSON: []byte(`{"id": "foo/bar", "service_type": "pg"}`)},
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Diagnostics, 1)
+		assert.Contains(t, resp.Diagnostics[0].Detail, "only the service resources are supported")
+	})
+
+	t.Run("empty state", func(t *testing.T) {
+		resp, err := s.MoveResourceState(ctx, &tfprotov6.MoveResourceStateRequest{
+			SourceTypeName: "aiven_pg",
+			TargetTypeName: "aiven_service",
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Diagnostics, 1)
+		assert.Equal(t, "the state of aiven_pg is empty", resp.Diagnostics[0].Detail)
+	})
+}
@@ -0,0 +1,43 @@
+package server
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/aiven/terraform-provider-aiven/internal/common"
+)
+
+// planWarningsServer adds the warnings of the SDK CustomizeDiff functions to the plan,
+// see common.AddPlanWarning.
+// It also adds the attribute errors, see common.AddPlanAttributeError.
+type planWarningsServer struct {
+	tfprotov6.ProviderServer
+}
+
+func (s *planWarningsServer) PlanResourceChange(ctx context.Context, req *tfprotov6.PlanResourceChangeRequest) (*tfprotov6.PlanResourceChangeResponse, error) {
+	ctx, warnings := common.WithPlanWarnings(ctx)
+	resp, err := s.ProviderServer.PlanResourceChange(ctx, req)
+	if err != nil || resp == nil {
+		return resp, err
+	}
+
+	for _, w := range warnings.List() {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov6.Diagnostic{
+			Severity: tfprotov6.DiagnosticSeverityWarning,
+			Summary:  w.Summary,
+			Detail:   w.Detail,
+		})
+	}
+
+	for _, e := range warnings.Errors() {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov6.Diagnostic{
+			Severity:  tfprotov6.DiagnosticSeverityError,
+			Summary:   e.Summary,
+			Detail:    e.Detail,
+			Attribute: tftypes.NewAttributePath().WithAttributeName(e.Attribute).WithElementKeyString(e.Key),
+		})
+	}
+	return resp, nil
+}
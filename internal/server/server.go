@@ -23,11 +23,17 @@ func NewMuxServer(ctx context.Context, version string) (tfprotov6.ProviderServer
 		return nil, err
 	}
 
+	// The SDK does not support list resources, the framework provider serves them
+	listResources, err := sdk.ListResources(ctx, sdkProvider)
+	if err != nil {
+		return nil, err
+	}
+
 	providers := []func() tfprotov6.ProviderServer{
 		func() tfprotov6.ProviderServer {
-			return sdkProvider
+			return &planWarningsServer{ProviderServer: sdkProvider}
 		},
-		providerserver.NewProtocol6(plugin.New(version)),
+		providerserver.NewProtocol6(plugin.New(version, listResources...)),
 	}
 
 	server, err := tf6muxserver.NewMuxServer(ctx, providers...)
@@ -74,6 +74,8 @@ func knownMissingSweepers() []string {
 		"aiven_organization_permission",
 		"aiven_pg_database",
 		"aiven_pg_user",
+		"aiven_service", // the service sweepers delete the services of any type
+		"aiven_service_maintenance",
 		"aiven_valkey_user",
 	}
 }
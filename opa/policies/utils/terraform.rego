@@ -31,6 +31,7 @@ aiven_service_types := {
 	"aiven_valkey",
 	"aiven_thanos",
 	"aiven_flink",
+	"aiven_service",
 }
 
 # Helper function to extract service name from service resources
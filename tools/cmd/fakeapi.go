@@ -0,0 +1,47 @@
+package cmd
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/spf13/cobra"
+
+	"github.com/aiven/terraform-provider-aiven/internal/fakeapi"
+)
+
+var fakeAPICmd = &cobra.Command{
+	Use:   "fakeapi",
+	Short: "Runs a local fake Aiven API server",
+	Long: `Runs an in-memory stand-in for the Aiven API, so the acceptance tests can run without network access.
+Point the provider to it with AIVEN_WEB_URL, any AIVEN_TOKEN is accepted.`,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		addr, err := cmd.Flags().GetString("addr")
+		if err != nil {
+			return fmt.Errorf("could not retrieve addr flag: %w", err)
+		}
+
+		projects, err := cmd.Flags().GetStringSlice("project")
+		if err != nil {
+			return fmt.Errorf("could not retrieve project flag: %w", err)
+		}
+
+		reads, err := cmd.Flags().GetInt("reads-until-running")
+		if err != nil {
+			return fmt.Errorf("could not retrieve reads-until-running flag: %w", err)
+		}
+
+		opts := []fakeapi.Option{fakeapi.WithReadsUntilRunning(reads)}
+		for _, p := range projects {
+			opts = append(opts, fakeapi.WithProject(p))
+		}
+
+		cmd.Printf("Fake Aiven API is listening on http://%s\n", addr)
+		return http.ListenAndServe(addr, fakeapi.New(opts...)) //nolint:gosec // local development server
+	},
+}
+
+func init() {
+	fakeAPICmd.Flags().String("addr", "localhost:8080", "Address to listen on")
+	fakeAPICmd.Flags().StringSlice("project", []string{"test-acc"}, "Projects to create on start")
+	fakeAPICmd.Flags().Int("reads-until-running", 1, "Number of service reads before it turns RUNNING")
+}
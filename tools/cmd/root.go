@@ -24,4 +24,5 @@ func init() {
 	rootCmd.AddCommand(changelogCmd)
 	rootCmd.AddCommand(versionCmd)
 	rootCmd.AddCommand(testsCmd)
+	rootCmd.AddCommand(fakeAPICmd)
 }